@@ -0,0 +1,48 @@
+// +build acceptance compute diagnostics
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/diagnostics"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestDiagnosticsGet(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	server, err := CreateServer(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteServer(t, client, server)
+
+	client.Microversion = "2.48"
+	diags, err := diagnostics.Get(client, server.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, diags)
+
+	th.AssertEquals(t, diags.State, "running")
+}
+
+func TestDiagnosticsCollect(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	server, err := CreateServer(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteServer(t, client, server)
+
+	results := diagnostics.Collect(client, []string{server.ID}, 2)
+	th.AssertEquals(t, len(results), 1)
+	th.AssertNoErr(t, results[0].Err)
+
+	tools.PrintResource(t, results[0].Legacy)
+}
@@ -0,0 +1,35 @@
+// +build acceptance compute externalevents
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/externalevents"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestExternalEventsNetworkChanged(t *testing.T) {
+	clients.RequireAdmin(t)
+	clients.RequireLong(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	server, err := CreateServer(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteServer(t, client, server)
+
+	events, err := externalevents.Send(client, externalevents.EventOpts{
+		Name:       externalevents.NetworkChanged,
+		ServerUUID: server.ID,
+	})
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, events)
+
+	th.AssertEquals(t, len(events), 1)
+	th.AssertEquals(t, events[0].ServerUUID, server.ID)
+}
@@ -90,6 +90,30 @@ func TestFlavorsCreateDelete(t *testing.T) {
 	tools.PrintResource(t, flavor)
 }
 
+func TestFlavorsUpdateDescription(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	flavor, err := CreateFlavor(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteFlavor(t, client, flavor)
+
+	client.Microversion = "2.55"
+
+	updateOpts := flavors.UpdateOpts{
+		Description: "gophercloud acceptance test flavor",
+	}
+
+	flavor, err = flavors.Update(client, flavor.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, flavor)
+
+	th.AssertEquals(t, flavor.Description, updateOpts.Description)
+}
+
 func TestFlavorsAccessesList(t *testing.T) {
 	clients.RequireAdmin(t)
 
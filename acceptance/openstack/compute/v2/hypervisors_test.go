@@ -93,3 +93,39 @@ func getHypervisorID(t *testing.T, client *gophercloud.ServiceClient) (int, erro
 
 	return 0, fmt.Errorf("Unable to get hypervisor ID")
 }
+
+func TestHypervisorsSearchAndListServers(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := hypervisors.List(client).AllPages()
+	th.AssertNoErr(t, err)
+
+	allHypervisors, err := hypervisors.ExtractHypervisors(allPages)
+	th.AssertNoErr(t, err)
+
+	if len(allHypervisors) == 0 {
+		t.Skip("No hypervisors found")
+	}
+
+	pattern := allHypervisors[0].HypervisorHostname
+
+	allPages, err = hypervisors.Search(client, pattern).AllPages()
+	th.AssertNoErr(t, err)
+
+	found, err := hypervisors.ExtractHypervisorSummaries(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, len(found) > 0)
+
+	allPages, err = hypervisors.ListServers(client, pattern).AllPages()
+	th.AssertNoErr(t, err)
+
+	withServers, err := hypervisors.ExtractHypervisorSummaries(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, h := range withServers {
+		tools.PrintResource(t, h)
+	}
+}
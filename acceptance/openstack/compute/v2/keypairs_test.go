@@ -46,7 +46,7 @@ func TestKeypairsCreateDelete(t *testing.T) {
 
 	tools.PrintResource(t, keyPair)
 
-	allPages, err := keypairs.List(client).AllPages()
+	allPages, err := keypairs.List(client, nil).AllPages()
 	th.AssertNoErr(t, err)
 
 	allKeys, err := keypairs.ExtractKeyPairs(allPages)
@@ -78,6 +78,25 @@ func TestKeypairsImportPublicKey(t *testing.T) {
 	tools.PrintResource(t, keyPair)
 }
 
+func TestKeypairsGenerateAndImport(t *testing.T) {
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	generateOpts := keypairs.GenerateOpts{
+		Name:      tools.RandomString("keypair_", 5),
+		Algorithm: keypairs.AlgorithmEd25519,
+	}
+
+	generated, err := keypairs.GenerateAndImport(client, generateOpts)
+	th.AssertNoErr(t, err)
+	defer DeleteKeyPair(t, client, &generated.KeyPair)
+
+	tools.PrintResource(t, generated.KeyPair)
+
+	_, err = ssh.ParsePrivateKey([]byte(generated.PrivateKey))
+	th.AssertNoErr(t, err)
+}
+
 func TestKeypairsServerCreateWithKey(t *testing.T) {
 	clients.RequireLong(t)
 
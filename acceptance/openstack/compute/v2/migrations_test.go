@@ -0,0 +1,49 @@
+// +build acceptance compute migrations
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/migrations"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestMigrationsList(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := migrations.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allMigrations, err := migrations.ExtractMigrations(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, migration := range allMigrations {
+		tools.PrintResource(t, migration)
+	}
+}
+
+func TestServerMigrationsList(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	server, err := CreateServer(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteServer(t, client, server)
+
+	client.Microversion = "2.23"
+	allPages, err := migrations.ListServerMigrations(client, server.ID).AllPages()
+	th.AssertNoErr(t, err)
+
+	allMigrations, err := migrations.ExtractServerMigrations(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, len(allMigrations), 0)
+}
@@ -0,0 +1,40 @@
+// +build acceptance compute quotaclasses
+
+package v2
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/quotaclasses"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestQuotaClassGetUpdate(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	orig, err := quotaclasses.Get(client, "default").Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, orig)
+
+	updateOpts := quotaclasses.UpdateOpts{
+		MetadataItems: gophercloud.IntToPointer(orig.MetadataItems + 1),
+	}
+
+	updated, err := quotaclasses.Update(client, "default", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, orig.MetadataItems+1, updated.MetadataItems)
+
+	restoreOpts := quotaclasses.UpdateOpts{
+		MetadataItems: gophercloud.IntToPointer(orig.MetadataItems),
+	}
+
+	_, err = quotaclasses.Update(client, "default", restoreOpts).Extract()
+	th.AssertNoErr(t, err)
+}
@@ -145,3 +145,23 @@ func TestQuotasetUpdateDelete(t *testing.T) {
 	orig.ID = ""
 	th.AssertDeepEquals(t, orig, res)
 }
+
+func TestQuotasetHeadroom(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	idclient, err := clients.NewIdentityV2Client()
+	th.AssertNoErr(t, err)
+
+	tenantid, err := getTenantIDByName(t, idclient, os.Getenv("OS_TENANT_NAME"))
+	th.AssertNoErr(t, err)
+
+	headroom, err := quotasets.GetHeadroom(client, tenantid)
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, headroom)
+
+	t.Logf("Servers with 1 vCPU and 512 MB RAM that still fit: %d", quotasets.MaxServers(headroom, 1, 512))
+}
@@ -25,7 +25,7 @@ func TestServergroupsCreateDelete(t *testing.T) {
 
 	tools.PrintResource(t, serverGroup)
 
-	allPages, err := servergroups.List(client).AllPages()
+	allPages, err := servergroups.List(client, nil).AllPages()
 	th.AssertNoErr(t, err)
 
 	allServerGroups, err := servergroups.ExtractServerGroups(allPages)
@@ -69,3 +69,25 @@ func TestServergroupsAffinityPolicy(t *testing.T) {
 
 	th.AssertEquals(t, firstServer.HostID, secondServer.HostID)
 }
+
+func TestServergroupsCheckPlacement(t *testing.T) {
+	clients.RequireLong(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	serverGroup, err := CreateServerGroup(t, client, "affinity")
+	th.AssertNoErr(t, err)
+	defer DeleteServerGroup(t, client, serverGroup)
+
+	firstServer, err := CreateServerInServerGroup(t, client, serverGroup)
+	th.AssertNoErr(t, err)
+	defer DeleteServer(t, client, firstServer)
+
+	secondServer, err := CreateServerInServerGroup(t, client, serverGroup)
+	th.AssertNoErr(t, err)
+	defer DeleteServer(t, client, secondServer)
+
+	err = servergroups.CheckPlacement(client, serverGroup.ID)
+	th.AssertNoErr(t, err)
+}
@@ -34,3 +34,40 @@ func TestServicesList(t *testing.T) {
 
 	th.AssertEquals(t, found, true)
 }
+
+func TestServicesDisableEnable(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := services.List(client).AllPages()
+	th.AssertNoErr(t, err)
+
+	allServices, err := services.ExtractServices(allPages)
+	th.AssertNoErr(t, err)
+
+	var hostOpts services.HostOpts
+	for _, service := range allServices {
+		if service.Binary == "nova-compute" && service.Status == "enabled" {
+			hostOpts = services.HostOpts{Host: service.Host, Binary: service.Binary}
+			break
+		}
+	}
+
+	if hostOpts.Host == "" {
+		t.Skip("No enabled nova-compute service found")
+	}
+
+	disabled, err := services.Disable(client, hostOpts, "acceptance test").Extract()
+	th.AssertNoErr(t, err)
+	defer services.Enable(client, hostOpts)
+
+	tools.PrintResource(t, disabled)
+	th.AssertEquals(t, "disabled", disabled.Status)
+	th.AssertEquals(t, "acceptance test", disabled.DisabledReason)
+
+	enabled, err := services.Enable(client, hostOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "enabled", enabled.Status)
+}
@@ -47,3 +47,31 @@ func TestUsageSingleTenant(t *testing.T) {
 		t.Fatalf("TotalHours should not be 0")
 	}
 }
+
+func TestUsageAllTenants(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	end := time.Now()
+	start := end.AddDate(0, -1, 0)
+	opts := usage.AllTenantsOpts{
+		Detailed: true,
+		Start:    &start,
+		End:      &end,
+	}
+
+	page, err := usage.AllTenants(client, opts).AllPages()
+	th.AssertNoErr(t, err)
+
+	allUsages, err := usage.ExtractAllTenants(page)
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, allUsages)
+
+	totals, err := usage.AllTenantsTotals(client, start, end)
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, totals)
+}
@@ -7,7 +7,10 @@ import (
 
 	"github.com/chjlangzi/gophercloud/acceptance/clients"
 	bs "github.com/chjlangzi/gophercloud/acceptance/openstack/blockstorage/v2"
+	bs3 "github.com/chjlangzi/gophercloud/acceptance/openstack/blockstorage/v3"
 	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/blockstorage/v3/volumes"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/volumeattach"
 	th "github.com/chjlangzi/gophercloud/testhelper"
 )
 
@@ -36,3 +39,42 @@ func TestVolumeAttachAttachment(t *testing.T) {
 
 	th.AssertEquals(t, volumeAttachment.ServerID, server.ID)
 }
+
+func TestVolumeAttachAttachAndWait(t *testing.T) {
+	clients.RequireLong(t)
+
+	client, err := clients.NewComputeV2Client()
+	th.AssertNoErr(t, err)
+
+	blockClient, err := clients.NewBlockStorageV3Client()
+	th.AssertNoErr(t, err)
+
+	server, err := CreateServer(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteServer(t, client, server)
+
+	volume, err := bs3.CreateVolume(t, blockClient)
+	th.AssertNoErr(t, err)
+	defer bs3.DeleteVolume(t, blockClient, volume)
+
+	client.Microversion = "2.79"
+	createOpts := volumeattach.CreateOpts{
+		VolumeID:            volume.ID,
+		Tag:                 "data",
+		DeleteOnTermination: false,
+	}
+
+	details, err := volumeattach.AttachAndWait(client, blockClient, server.ID, createOpts, 120)
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, details)
+
+	th.AssertEquals(t, details.Attachment.ServerID, server.ID)
+	th.AssertEquals(t, details.VolumeStatus, "in-use")
+
+	err = volumeattach.Delete(client, server.ID, volume.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = volumes.WaitForStatus(blockClient, volume.ID, "available", 60)
+	th.AssertNoErr(t, err)
+}
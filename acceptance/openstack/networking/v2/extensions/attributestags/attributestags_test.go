@@ -0,0 +1,59 @@
+// +build acceptance networking tags
+
+package attributestags
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	networking "github.com/chjlangzi/gophercloud/acceptance/openstack/networking/v2"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/attributestags"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/networks"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestTags(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	network, err := networking.CreateNetwork(t, client)
+	th.AssertNoErr(t, err)
+	defer networking.DeleteNetwork(t, client, network.ID)
+
+	tags, err := attributestags.ReplaceAll(client, attributestags.ResourceNetworks, network.ID, attributestags.ReplaceAllOpts{
+		Tags: []string{"a", "b", "c"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	sort.Strings(tags)
+	th.AssertDeepEquals(t, []string{"a", "b", "c"}, tags)
+
+	err = attributestags.Add(client, attributestags.ResourceNetworks, network.ID, "d").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = attributestags.Delete(client, attributestags.ResourceNetworks, network.ID, "a").ExtractErr()
+	th.AssertNoErr(t, err)
+
+	exists, err := attributestags.Check(client, attributestags.ResourceNetworks, network.ID, "d").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, exists)
+
+	exists, err = attributestags.Check(client, attributestags.ResourceNetworks, network.ID, "a").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, exists)
+
+	allPages, err := networks.List(client, networks.ListOpts{Tags: "b,d"}).AllPages()
+	th.AssertNoErr(t, err)
+
+	allNetworks, err := networks.ExtractNetworks(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(allNetworks))
+	th.AssertEquals(t, network.ID, allNetworks[0].ID)
+
+	err = attributestags.DeleteAll(client, attributestags.ResourceNetworks, network.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	tags, err = attributestags.List(client, attributestags.ResourceNetworks, network.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(tags))
+}
@@ -0,0 +1,48 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/dns"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/ports"
+)
+
+// PortWithDNSExt represents a port with the DNS fields
+type PortWithDNSExt struct {
+	ports.Port
+	dns.PortDNSExt
+}
+
+// CreatePortDNS will create a port with a DNS name on the specified subnet.
+// An error will be returned if the port could not be created.
+func CreatePortDNS(t *testing.T, client *gophercloud.ServiceClient, networkID, subnetID, dnsName string) (PortWithDNSExt, error) {
+	portName := tools.RandomString("TESTACC-", 8)
+	iFalse := false
+
+	t.Logf("Attempting to create port: %s", portName)
+
+	portCreateOpts := ports.CreateOpts{
+		NetworkID:    networkID,
+		Name:         portName,
+		AdminStateUp: &iFalse,
+		FixedIPs:     []ports.IP{ports.IP{SubnetID: subnetID}},
+	}
+
+	createOpts := dns.PortCreateOptsExt{
+		CreateOptsBuilder: portCreateOpts,
+		DNSName:           dnsName,
+	}
+
+	var s PortWithDNSExt
+
+	err := ports.Create(client, createOpts).ExtractInto(&s)
+	if err != nil {
+		return s, err
+	}
+
+	t.Logf("Successfully created port: %s", portName)
+
+	return s, nil
+}
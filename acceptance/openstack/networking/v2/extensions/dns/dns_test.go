@@ -0,0 +1,88 @@
+// +build acceptance networking
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	networking "github.com/chjlangzi/gophercloud/acceptance/openstack/networking/v2"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/dns"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/ports"
+)
+
+func TestDNSPortCRUD(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	if err != nil {
+		t.Fatalf("Unable to create a network client: %v", err)
+	}
+
+	// Create Network
+	network, err := networking.CreateNetwork(t, client)
+	if err != nil {
+		t.Fatalf("Unable to create network: %v", err)
+	}
+	defer networking.DeleteNetwork(t, client, network.ID)
+
+	// Create Subnet
+	subnet, err := networking.CreateSubnet(t, client, network.ID)
+	if err != nil {
+		t.Fatalf("Unable to create subnet: %v", err)
+	}
+	defer networking.DeleteSubnet(t, client, subnet.ID)
+
+	// Create port
+	dnsName := tools.RandomString("testacc-", 8)
+	port, err := CreatePortDNS(t, client, network.ID, subnet.ID, dnsName)
+	if err != nil {
+		t.Fatalf("Unable to create port: %v", err)
+	}
+	defer networking.DeletePort(t, client, port.ID)
+
+	tools.PrintResource(t, port)
+
+	if port.DNSName != dnsName {
+		t.Fatalf("Expected dns_name %s, got %s", dnsName, port.DNSName)
+	}
+
+	// List ports by DNS name
+	listOpts := dns.PortListOptsExt{
+		ListOptsBuilder: ports.ListOpts{NetworkID: network.ID},
+		DNSName:         dnsName,
+	}
+
+	allPages, err := ports.List(client, listOpts).AllPages()
+	if err != nil {
+		t.Fatalf("Unable to list ports: %v", err)
+	}
+
+	var allPorts []PortWithDNSExt
+	err = ports.ExtractPortsInto(allPages, &allPorts)
+	if err != nil {
+		t.Fatalf("Unable to extract ports: %v", err)
+	}
+
+	if len(allPorts) != 1 || allPorts[0].ID != port.ID {
+		t.Fatalf("Expected to find port %s by dns_name %s", port.ID, dnsName)
+	}
+
+	// Update port
+	newDNSName := tools.RandomString("testacc-", 8)
+	updateOpts := dns.PortUpdateOptsExt{
+		UpdateOptsBuilder: ports.UpdateOpts{},
+		DNSName:           &newDNSName,
+	}
+
+	var newPort PortWithDNSExt
+	err = ports.Update(client, port.ID, updateOpts).ExtractInto(&newPort)
+	if err != nil {
+		t.Fatalf("Could not update port: %v", err)
+	}
+
+	tools.PrintResource(t, newPort)
+
+	if newPort.DNSName != newDNSName {
+		t.Fatalf("Expected dns_name %s, got %s", newDNSName, newPort.DNSName)
+	}
+}
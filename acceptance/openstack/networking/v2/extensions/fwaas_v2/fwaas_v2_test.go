@@ -0,0 +1,78 @@
+// +build acceptance networking fwaas_v2
+
+package fwaas_v2
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/fwaas_v2/groups"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/fwaas_v2/policies"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestPolicyInsertRemoveRule(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	rule1, err := CreateRule(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteRule(t, client, rule1.ID)
+
+	rule2, err := CreateRule(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteRule(t, client, rule2.ID)
+
+	policy, err := CreatePolicy(t, client, rule1.ID)
+	th.AssertNoErr(t, err)
+	defer DeletePolicy(t, client, policy.ID)
+
+	policy, err = policies.InsertRule(client, policy.ID, policies.InsertRuleOpts{
+		ID:           rule2.ID,
+		InsertBefore: rule1.ID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, policy)
+	th.AssertDeepEquals(t, []string{rule2.ID, rule1.ID}, policy.Rules)
+
+	policy, err = policies.RemoveRule(client, policy.ID, rule2.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, policy)
+	th.AssertDeepEquals(t, []string{rule1.ID}, policy.Rules)
+}
+
+func TestGroupCRUD(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	rule, err := CreateRule(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteRule(t, client, rule.ID)
+
+	policy, err := CreatePolicy(t, client, rule.ID)
+	th.AssertNoErr(t, err)
+	defer DeletePolicy(t, client, policy.ID)
+
+	group, err := CreateGroup(t, client, policy.ID)
+	th.AssertNoErr(t, err)
+	defer DeleteGroup(t, client, group.ID)
+
+	tools.PrintResource(t, group)
+
+	description := "Some group description"
+	_, err = groups.Update(client, group.ID, groups.UpdateOpts{
+		Description: &description,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	group, err = groups.RemoveEgressPolicy(client, group.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, group)
+	th.AssertEquals(t, description, group.Description)
+	th.AssertEquals(t, policy.ID, group.IngressFirewallPolicyID)
+	th.AssertEquals(t, "", group.EgressFirewallPolicyID)
+}
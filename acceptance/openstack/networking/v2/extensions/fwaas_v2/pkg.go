@@ -0,0 +1 @@
+package fwaas_v2
@@ -6,6 +6,7 @@ import (
 	"github.com/chjlangzi/gophercloud"
 	"github.com/chjlangzi/gophercloud/acceptance/clients"
 	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/layer3/addressscopes"
 	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/chjlangzi/gophercloud/openstack/networking/v2/ports"
@@ -248,3 +249,36 @@ func WaitForRouterInterfaceToDetach(client *gophercloud.ServiceClient, routerInt
 		return false, nil
 	})
 }
+
+// CreateAddressScope will create an address-scope. An error will be returned
+// if the address-scope could not be created.
+func CreateAddressScope(t *testing.T, client *gophercloud.ServiceClient) (*addressscopes.AddressScope, error) {
+	addressScopeName := tools.RandomString("TESTACC-", 8)
+	createOpts := addressscopes.CreateOpts{
+		Name:      addressScopeName,
+		IPVersion: 4,
+	}
+
+	t.Logf("Attempting to create an address-scope: %s", addressScopeName)
+
+	addressScope, err := addressscopes.Create(client, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	t.Logf("Successfully created the address-scope.")
+	return addressScope, nil
+}
+
+// DeleteAddressScope will delete an address-scope with the specified ID.
+// A fatal error will occur if the delete was not successful.
+func DeleteAddressScope(t *testing.T, client *gophercloud.ServiceClient, addressScopeID string) {
+	t.Logf("Attempting to delete the address-scope: %s", addressScopeID)
+
+	err := addressscopes.Delete(client, addressScopeID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Unable to delete address-scope %s: %v", addressScopeID, err)
+	}
+
+	t.Logf("Deleted address-scope: %s", addressScopeID)
+}
@@ -3,6 +3,7 @@
 package layer3
 
 import (
+	"net"
 	"testing"
 
 	"github.com/chjlangzi/gophercloud/acceptance/clients"
@@ -117,3 +118,86 @@ func TestLayer3RouterInterface(t *testing.T) {
 		t.Fatalf("Failed to remove interface from router: %v", err)
 	}
 }
+
+func TestLayer3RouterExtraRoutes(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	if err != nil {
+		t.Fatalf("Unable to create a network client: %v", err)
+	}
+
+	network, err := networking.CreateNetwork(t, client)
+	if err != nil {
+		t.Fatalf("Unable to create network: %v", err)
+	}
+	defer networking.DeleteNetwork(t, client, network.ID)
+
+	subnet, err := networking.CreateSubnet(t, client, network.ID)
+	if err != nil {
+		t.Fatalf("Unable to create subnet: %v", err)
+	}
+	defer networking.DeleteSubnet(t, client, subnet.ID)
+
+	router, err := CreateExternalRouter(t, client)
+	if err != nil {
+		t.Fatalf("Unable to create router: %v", err)
+	}
+	defer DeleteRouter(t, client, router.ID)
+
+	aiOpts := routers.AddInterfaceOpts{
+		SubnetID: subnet.ID,
+	}
+
+	_, err = routers.AddInterface(client, router.ID, aiOpts).Extract()
+	if err != nil {
+		t.Fatalf("Failed to add interface to router: %v", err)
+	}
+	defer func() {
+		riOpts := routers.RemoveInterfaceOpts{
+			SubnetID: subnet.ID,
+		}
+		routers.RemoveInterface(client, router.ID, riOpts)
+	}()
+
+	_, cidr, err := net.ParseCIDR(subnet.CIDR)
+	if err != nil {
+		t.Fatalf("Unable to parse subnet CIDR: %v", err)
+	}
+	nextHop := cidr.IP.To4()
+	nextHop[3] = 5
+
+	opts := routers.ExtraRoutesOpts{
+		Routes: []routers.Route{
+			{DestinationCIDR: "10.250.1.0/24", NextHop: nextHop.String()},
+			{DestinationCIDR: "10.250.2.0/24", NextHop: nextHop.String()},
+		},
+	}
+
+	newRouter, err := routers.AddExtraRoutes(client, router.ID, opts).Extract()
+	if err != nil {
+		t.Fatalf("Unable to add extra routes: %v", err)
+	}
+
+	tools.PrintResource(t, newRouter)
+
+	if len(newRouter.Routes) != 2 {
+		t.Fatalf("Expected 2 routes, got %d", len(newRouter.Routes))
+	}
+
+	opts.Routes = opts.Routes[:1]
+	newRouter, err = routers.RemoveExtraRoutes(client, router.ID, opts).Extract()
+	if err != nil {
+		t.Fatalf("Unable to remove extra routes: %v", err)
+	}
+
+	tools.PrintResource(t, newRouter)
+
+	if len(newRouter.Routes) != 1 {
+		t.Fatalf("Expected 1 route, got %d", len(newRouter.Routes))
+	}
+
+	opts.Routes = newRouter.Routes
+	_, err = routers.RemoveExtraRoutes(client, router.ID, opts).Extract()
+	if err != nil {
+		t.Fatalf("Unable to remove extra routes: %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+// +build acceptance networking networkipavailabilities
+
+package networkipavailabilities
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	networking "github.com/chjlangzi/gophercloud/acceptance/openstack/networking/v2"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/networkipavailabilities"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestNetworkIPAvailabilityGet(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	network, err := networking.CreateNetwork(t, client)
+	th.AssertNoErr(t, err)
+	defer networking.DeleteNetwork(t, client, network.ID)
+
+	subnet, err := networking.CreateSubnet(t, client, network.ID)
+	th.AssertNoErr(t, err)
+	defer networking.DeleteSubnet(t, client, subnet.ID)
+
+	availability, err := networkipavailabilities.Get(client, network.ID).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, availability)
+
+	th.AssertEquals(t, network.ID, availability.NetworkID)
+	th.AssertEquals(t, 1, len(availability.SubnetIPAvailabilities))
+	th.AssertEquals(t, subnet.ID, availability.SubnetIPAvailabilities[0].SubnetID)
+}
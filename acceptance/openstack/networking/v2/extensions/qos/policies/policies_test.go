@@ -0,0 +1,69 @@
+// +build acceptance networking qos policies
+
+package policies
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/qos/rules"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestPoliciesCRUD(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	createOpts := policies.CreateOpts{
+		Name:        tools.RandomString("TESTACC-", 8),
+		Description: "acceptance test policy",
+	}
+
+	policy, err := policies.Create(client, createOpts).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		err := policies.Delete(client, policy.ID).ExtractErr()
+		th.AssertNoErr(t, err)
+	}()
+
+	tools.PrintResource(t, policy)
+
+	description := ""
+	updateOpts := policies.UpdateOpts{
+		Description: &description,
+	}
+
+	policy, err = policies.Update(client, policy.ID, updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "", policy.Description)
+
+	rule, err := rules.CreateBandwidthLimitRule(client, policy.ID, rules.CreateBandwidthLimitRuleOpts{
+		MaxKBps:      3000,
+		MaxBurstKBps: 300,
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, rule)
+
+	maxKBps := 500
+	rule, err = rules.UpdateBandwidthLimitRule(client, policy.ID, rule.ID, rules.UpdateBandwidthLimitRuleOpts{
+		MaxKBps: &maxKBps,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 500, rule.MaxKBps)
+
+	allPages, err := policies.List(client, policies.ListOpts{Name: policy.Name}).AllPages()
+	th.AssertNoErr(t, err)
+
+	allPolicies, err := policies.ExtractPolicies(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(allPolicies))
+	th.AssertEquals(t, 1, len(allPolicies[0].Rules))
+
+	err = rules.DeleteBandwidthLimitRule(client, policy.ID, rule.ID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
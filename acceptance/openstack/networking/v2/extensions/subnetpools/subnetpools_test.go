@@ -63,3 +63,41 @@ func TestSubnetPoolsList(t *testing.T) {
 		tools.PrintResource(t, subnetpool)
 	}
 }
+
+func TestSubnetPoolsPrefixes(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	if err != nil {
+		t.Fatalf("Unable to create a network client: %v", err)
+	}
+
+	subnetPool, err := CreateSubnetPool(t, client)
+	if err != nil {
+		t.Fatalf("Unable to create a subnetpool: %v", err)
+	}
+	defer DeleteSubnetPool(t, client, subnetPool.ID)
+
+	prefixes, err := subnetpools.AddPrefixes(client, subnetPool.ID, subnetpools.PrefixesOpts{
+		Prefixes: []string{"192.168.0.0/16"},
+	}).Extract()
+	if err != nil {
+		t.Fatalf("Unable to add prefixes to the subnetpool: %v", err)
+	}
+
+	t.Logf("Prefixes after addition: %v", prefixes)
+
+	prefix, err := subnetpools.FindNextFreePrefix(client, subnetPool.ID, 24)
+	if err != nil {
+		t.Fatalf("Unable to compute the next free prefix: %v", err)
+	}
+
+	t.Logf("Next free /24 prefix: %s", prefix)
+
+	prefixes, err = subnetpools.RemovePrefixes(client, subnetPool.ID, subnetpools.PrefixesOpts{
+		Prefixes: []string{"192.168.0.0/16"},
+	}).Extract()
+	if err != nil {
+		t.Fatalf("Unable to remove prefixes from the subnetpool: %v", err)
+	}
+
+	t.Logf("Prefixes after removal: %v", prefixes)
+}
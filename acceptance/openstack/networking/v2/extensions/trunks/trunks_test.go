@@ -0,0 +1,79 @@
+// +build acceptance trunks
+
+package trunks
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	networking "github.com/chjlangzi/gophercloud/acceptance/openstack/networking/v2"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/ports"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestTrunkSubports(t *testing.T) {
+	client, err := clients.NewNetworkV2Client()
+	th.AssertNoErr(t, err)
+
+	network, err := networking.CreateNetwork(t, client)
+	th.AssertNoErr(t, err)
+	defer networking.DeleteNetwork(t, client, network.ID)
+
+	subnet, err := networking.CreateSubnet(t, client, network.ID)
+	th.AssertNoErr(t, err)
+	defer networking.DeleteSubnet(t, client, subnet.ID)
+
+	parentPort, err := networking.CreatePort(t, client, network.ID, subnet.ID)
+	th.AssertNoErr(t, err)
+	defer networking.DeletePort(t, client, parentPort.ID)
+
+	subport, err := networking.CreatePort(t, client, network.ID, subnet.ID)
+	th.AssertNoErr(t, err)
+	defer networking.DeletePort(t, client, subport.ID)
+
+	trunk, err := trunks.Create(client, trunks.CreateOpts{
+		Name:   tools.RandomString("TESTACC-", 8),
+		PortID: parentPort.ID,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	defer func() {
+		err := trunks.Delete(client, trunk.ID).ExtractErr()
+		th.AssertNoErr(t, err)
+	}()
+
+	tools.PrintResource(t, trunk)
+
+	_, err = trunks.AddSubports(client, trunk.ID, trunks.AddSubportsOpts{
+		Subports: []trunks.Subport{
+			{
+				SegmentationID:   100,
+				SegmentationType: trunks.SegmentationTypeVLAN,
+				PortID:           subport.ID,
+			},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	subports, err := trunks.GetSubports(client, trunk.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 1, len(subports))
+	th.AssertEquals(t, subport.ID, subports[0].PortID)
+
+	port, err := ports.Get(client, parentPort.ID).Extract()
+	th.AssertNoErr(t, err)
+	if port.TrunkDetails != nil {
+		tools.PrintResource(t, port.TrunkDetails)
+		th.AssertEquals(t, trunk.ID, port.TrunkDetails.TrunkID)
+	}
+
+	_, err = trunks.RemoveSubports(client, trunk.ID, trunks.RemoveSubportsOpts{
+		Subports: []trunks.RemoveSubport{{PortID: subport.ID}},
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	subports, err = trunks.GetSubports(client, trunk.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, len(subports))
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/resourceproviders"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+// CreateResourceProvider will create a resource provider with a random name.
+// An error will be returned if the resource provider could not be created.
+func CreateResourceProvider(t *testing.T, client *gophercloud.ServiceClient) (*resourceproviders.ResourceProvider, error) {
+	name := tools.RandomString("TESTACC-", 8)
+	t.Logf("Attempting to create resource provider: %s", name)
+
+	createOpts := resourceproviders.CreateOpts{
+		Name: name,
+	}
+
+	client.Microversion = "1.20"
+	rp, err := resourceproviders.Create(client, createOpts).Extract()
+	if err != nil {
+		return rp, err
+	}
+
+	t.Logf("Successfully created resource provider: %s", rp.UUID)
+
+	th.AssertEquals(t, rp.Name, name)
+
+	return rp, nil
+}
+
+// DeleteResourceProvider will delete a resource provider. A fatal error will
+// occur if the resource provider could not be deleted.
+func DeleteResourceProvider(t *testing.T, client *gophercloud.ServiceClient, resourceProviderID string) {
+	t.Logf("Attempting to delete resource provider: %s", resourceProviderID)
+
+	err := resourceproviders.Delete(client, resourceProviderID).ExtractErr()
+	if err != nil {
+		t.Fatalf("Unable to delete resource provider %s: %v", resourceProviderID, err)
+	}
+
+	t.Logf("Deleted resource provider: %s", resourceProviderID)
+}
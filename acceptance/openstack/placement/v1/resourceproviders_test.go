@@ -0,0 +1,75 @@
+// +build acceptance placement resourceproviders
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/resourceproviders"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestResourceProvidersList(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewPlacementV1Client()
+	th.AssertNoErr(t, err)
+
+	allPages, err := resourceproviders.List(client, nil).AllPages()
+	th.AssertNoErr(t, err)
+
+	allResourceProviders, err := resourceproviders.ExtractResourceProviders(allPages)
+	th.AssertNoErr(t, err)
+
+	for _, rp := range allResourceProviders {
+		tools.PrintResource(t, rp)
+	}
+}
+
+func TestResourceProvidersCRUD(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewPlacementV1Client()
+	th.AssertNoErr(t, err)
+
+	rp, err := CreateResourceProvider(t, client)
+	th.AssertNoErr(t, err)
+	defer DeleteResourceProvider(t, client, rp.UUID)
+
+	tools.PrintResource(t, rp)
+
+	newName := tools.RandomString("TESTACC-", 8)
+	updated, err := resourceproviders.Update(client, rp.UUID, resourceproviders.UpdateOpts{
+		Name: newName,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, newName, updated.Name)
+
+	inventories, err := resourceproviders.UpdateInventories(client, rp.UUID, resourceproviders.UpdateInventoriesOpts{
+		ResourceProviderGeneration: updated.Generation,
+		Inventories: map[string]resourceproviders.Inventory{
+			"VCPU": {
+				Total: 8,
+			},
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, inventories)
+
+	capacity, err := resourceproviders.GetCapacity(client, rp.UUID)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 0, capacity["VCPU"].Used)
+
+	tools.PrintResource(t, capacity)
+
+	traits, err := resourceproviders.UpdateTraits(client, rp.UUID, resourceproviders.UpdateTraitsOpts{
+		ResourceProviderGeneration: inventories.ResourceProviderGeneration,
+		Traits:                     []string{"HW_CPU_X86_AVX2"},
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	tools.PrintResource(t, traits)
+}
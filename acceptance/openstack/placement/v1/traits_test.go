@@ -0,0 +1,43 @@
+// +build acceptance placement traits
+
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/acceptance/clients"
+	"github.com/chjlangzi/gophercloud/acceptance/tools"
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/traits"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestTraitsCRUD(t *testing.T) {
+	clients.RequireAdmin(t)
+
+	client, err := clients.NewPlacementV1Client()
+	th.AssertNoErr(t, err)
+
+	name := strings.ToUpper(tools.RandomString("CUSTOM_TESTACC_", 8))
+	err = traits.Create(client, name).ExtractErr()
+	th.AssertNoErr(t, err)
+	defer traits.Delete(client, name)
+
+	err = traits.Get(client, name).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	allPages, err := traits.List(client, traits.ListOpts{Name: "startswith:CUSTOM_"}).AllPages()
+	th.AssertNoErr(t, err)
+
+	allTraits, err := traits.ExtractTraits(allPages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, trait := range allTraits {
+		if trait == name {
+			found = true
+		}
+	}
+
+	th.AssertEquals(t, true, found)
+}
@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/bulk"
+)
+
+// BulkCreate posts the create request bodies of several resources of the same
+// kind to url in a single request and stores the response in body. Each of
+// the bodies is keyed by the singular resource name, as built by the
+// CreateOptsBuilder of the resource, and they are sent as a list keyed by
+// the plural one. A bulk.ErrNotSupported is returned if the Networking
+// service has bulk operations disabled.
+func BulkCreate(c *gophercloud.ServiceClient, url, singular, plural string, bodies []map[string]interface{}, body *interface{}) error {
+	if len(bodies) == 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "opts"
+		return err
+	}
+
+	resources := make([]interface{}, len(bodies))
+	for i, b := range bodies {
+		v, ok := b[singular]
+		if !ok {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = fmt.Sprintf("opts[%d].%s", i, singular)
+			return err
+		}
+		resources[i] = v
+	}
+
+	b := map[string]interface{}{plural: resources}
+	_, err := c.Post(url, b, body, nil)
+	if isBulkNotSupported(err) {
+		return bulk.ErrNotSupported{Resource: plural, Err: err}
+	}
+	return err
+}
+
+// isBulkNotSupported tells whether err is the response of a Networking
+// service which has bulk operations disabled.
+func isBulkNotSupported(err error) bool {
+	e, ok := err.(gophercloud.ErrDefault400)
+	return ok && bytes.Contains(e.Body, []byte("Bulk operation not supported"))
+}
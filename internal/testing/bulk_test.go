@@ -0,0 +1,124 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/internal"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/bulk"
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestBulkCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"networks": [{"name": "net1"}, {"name": "net2"}]}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, `{"networks": [{"name": "net1"}, {"name": "net2"}]}`)
+	})
+
+	bodies := []map[string]interface{}{
+		{"network": map[string]interface{}{"name": "net1"}},
+		{"network": map[string]interface{}{"name": "net2"}},
+	}
+
+	var body interface{}
+	err := internal.BulkCreate(fake.ServiceClient(), th.Endpoint()+"v2.0/networks", "network", "networks", bodies, &body)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(body.(map[string]interface{})["networks"].([]interface{})))
+}
+
+func TestBulkCreateNotSupported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		fmt.Fprintf(w, `{"NeutronError": {"type": "HTTPBadRequest", "message": "Bulk operation not supported", "detail": ""}}`)
+	})
+
+	bodies := []map[string]interface{}{
+		{"network": map[string]interface{}{"name": "net1"}},
+	}
+
+	var body interface{}
+	err := internal.BulkCreate(fake.ServiceClient(), th.Endpoint()+"v2.0/networks", "network", "networks", bodies, &body)
+	e, ok := err.(bulk.ErrNotSupported)
+	if !ok {
+		t.Fatalf("Expected bulk.ErrNotSupported, got %v", err)
+	}
+	th.AssertEquals(t, "networks", e.Resource)
+
+	original, ok := e.Err.(gophercloud.ErrDefault400)
+	if !ok {
+		t.Fatalf("Expected the original 400 error to be kept, got %v", e.Err)
+	}
+	if !strings.Contains(string(original.Body), "Bulk operation not supported") {
+		t.Fatalf("Expected the response body to be kept, got %s", original.Body)
+	}
+	if !strings.Contains(e.Error(), "Bulk operation not supported") {
+		t.Fatalf("Expected the error message to include the response, got %s", e.Error())
+	}
+}
+
+func TestBulkCreateOtherError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		fmt.Fprintf(w, `{"NeutronError": {"type": "InvalidInput", "message": "Invalid input for operation", "detail": ""}}`)
+	})
+
+	bodies := []map[string]interface{}{
+		{"network": map[string]interface{}{"name": "net1"}},
+	}
+
+	var body interface{}
+	err := internal.BulkCreate(fake.ServiceClient(), th.Endpoint()+"v2.0/networks", "network", "networks", bodies, &body)
+	if _, ok := err.(gophercloud.ErrDefault400); !ok {
+		t.Fatalf("Expected a plain 400 error, got %v", err)
+	}
+}
+
+func TestBulkCreateRequiredBodies(t *testing.T) {
+	var body interface{}
+	err := internal.BulkCreate(fake.ServiceClient(), th.Endpoint()+"v2.0/networks", "network", "networks", nil, &body)
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("Expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestBulkCreateBodyWithoutSingularKey(t *testing.T) {
+	bodies := []map[string]interface{}{
+		{"network": map[string]interface{}{"name": "net1"}},
+		{"port": map[string]interface{}{"name": "port1"}},
+	}
+
+	var body interface{}
+	err := internal.BulkCreate(fake.ServiceClient(), th.Endpoint()+"v2.0/networks", "network", "networks", bodies, &body)
+	e, ok := err.(gophercloud.ErrMissingInput)
+	if !ok {
+		t.Fatalf("Expected ErrMissingInput, got %v", err)
+	}
+	th.AssertEquals(t, "opts[1].network", e.Argument)
+}
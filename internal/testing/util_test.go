@@ -40,3 +40,20 @@ func TestRemainingKeys(t *testing.T) {
 		t.Fatalf("expected %s but got %s", expected, actual)
 	}
 }
+
+func TestMergeQuery(t *testing.T) {
+	cases := []struct {
+		base, ext, expected string
+	}{
+		{"", "", ""},
+		{"?name=foo", "", "?name=foo"},
+		{"", "?dns_name=bar", "?dns_name=bar"},
+		{"?name=foo", "?dns_name=bar", "?name=foo&dns_name=bar"},
+	}
+
+	for _, c := range cases {
+		if actual := internal.MergeQuery(c.base, c.ext); actual != c.expected {
+			t.Errorf("expected %q but got %q", c.expected, actual)
+		}
+	}
+}
@@ -32,3 +32,16 @@ func RemainingKeys(s interface{}, m map[string]interface{}) (extras map[string]i
 
 	return
 }
+
+// MergeQuery appends the parameters of an extension's query string to the
+// base query string built by the options it extends. Both are expected in
+// the "?key=value" form returned by gophercloud.BuildQueryString.
+func MergeQuery(base, ext string) string {
+	if ext == "" {
+		return base
+	}
+	if base == "" {
+		return ext
+	}
+	return base + "&" + ext[1:]
+}
@@ -414,3 +414,10 @@ func NewKeyManagerV1(client *gophercloud.ProviderClient, eo gophercloud.Endpoint
 	sc.ResourceBase = sc.Endpoint + "v1/"
 	return sc, err
 }
+
+// NewPlacementV1 creates a ServiceClient that may be used with the v1
+// placement package. Set its Microversion field to use a particular
+// placement microversion.
+func NewPlacementV1(client *gophercloud.ProviderClient, eo gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error) {
+	return initClientOpts(client, eo, "placement")
+}
@@ -0,0 +1,53 @@
+/*
+Package diagnostics provides the ability to retrieve the diagnostics of
+servers through the OpenStack Compute service.
+
+The format of the diagnostics depends on the microversion of the client.
+Before microversion 2.48 the response is a free-form, hypervisor-specific set
+of key/value pairs which can be retrieved with ExtractLegacy. From
+microversion 2.48 on the response follows a standard schema which can be
+retrieved with Extract.
+
+Example to Get the Diagnostics of a Server
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	computeClient.Microversion = "2.48"
+	diags, err := diagnostics.Get(computeClient, serverID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, nic := range diags.NICDetails {
+		fmt.Printf("%s: %d bytes received\n", nic.MACAddress, nic.RxOctets)
+	}
+
+Example to Get the Legacy Diagnostics of a Server
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	diags, err := diagnostics.Get(computeClient, serverID).ExtractLegacy()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%v\n", diags["memory"])
+
+Example to Collect the Diagnostics of Many Servers
+
+	serverIDs := []string{
+		"b16ba811-199d-4ffd-8839-ba96c1185a67",
+		"9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+	}
+
+	computeClient.Microversion = "2.48"
+	for _, result := range diagnostics.Collect(computeClient, serverIDs, 10) {
+		if result.Err != nil {
+			fmt.Printf("%s: %s\n", result.ServerID, result.Err)
+			continue
+		}
+
+		fmt.Printf("%s: %+v\n", result.ServerID, result.Diagnostics)
+	}
+*/
+package diagnostics
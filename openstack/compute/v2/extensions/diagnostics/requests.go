@@ -0,0 +1,14 @@
+package diagnostics
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// Get retrieves the diagnostics of a server. The format of the result depends
+// on the microversion of the client, see Extract and ExtractLegacy.
+func Get(client *gophercloud.ServiceClient, serverID string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, serverID), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
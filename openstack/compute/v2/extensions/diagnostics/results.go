@@ -0,0 +1,151 @@
+package diagnostics
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as standardized Diagnostics, or its ExtractLegacy method to
+// interpret it as LegacyDiagnostics.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as standardized Diagnostics. It requires the
+// request to have been made with microversion 2.48 or later.
+func (r GetResult) Extract() (*Diagnostics, error) {
+	var s Diagnostics
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// ExtractLegacy interprets a GetResult as LegacyDiagnostics, as returned
+// by microversions older than 2.48.
+func (r GetResult) ExtractLegacy() (LegacyDiagnostics, error) {
+	var s LegacyDiagnostics
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// LegacyDiagnostics are the free-form diagnostics of a server returned by
+// microversions older than 2.48. The keys and values depend on the
+// hypervisor driver, e.g. "cpu0_time" or "vda_read" with libvirt.
+type LegacyDiagnostics map[string]interface{}
+
+// Diagnostics are the standardized diagnostics of a server returned by
+// microversion 2.48 and later.
+type Diagnostics struct {
+	// State is the current state of the server, e.g. "running" or "paused".
+	State string `json:"state"`
+
+	// Driver is the name of the hypervisor driver, e.g. "libvirt".
+	Driver string `json:"driver"`
+
+	// Hypervisor is the type of the hypervisor, e.g. "kvm".
+	Hypervisor string `json:"hypervisor"`
+
+	// HypervisorOS is the operating system of the hypervisor.
+	HypervisorOS string `json:"hypervisor_os"`
+
+	// Uptime is the amount of time in seconds the server has been running.
+	Uptime int64 `json:"uptime"`
+
+	// ConfigDrive indicates whether the server uses a config drive.
+	ConfigDrive bool `json:"config_drive"`
+
+	// NumCPUs is the number of vCPUs of the server.
+	NumCPUs int `json:"num_cpus"`
+
+	// NumNICs is the number of network interfaces of the server.
+	NumNICs int `json:"num_nics"`
+
+	// NumDisks is the number of disks of the server.
+	NumDisks int `json:"num_disks"`
+
+	// CPUDetails contains the details of each vCPU of the server.
+	CPUDetails []CPUDetails `json:"cpu_details"`
+
+	// NICDetails contains the details of each network interface of the server.
+	NICDetails []NICDetails `json:"nic_details"`
+
+	// DiskDetails contains the details of each disk of the server.
+	DiskDetails []DiskDetails `json:"disk_details"`
+
+	// MemoryDetails contains the details of the memory of the server.
+	MemoryDetails MemoryDetails `json:"memory_details"`
+}
+
+// CPUDetails are the diagnostics of a single vCPU.
+type CPUDetails struct {
+	// ID is the ID of the vCPU.
+	ID int `json:"id"`
+
+	// Time is the CPU time of the vCPU in nanoseconds.
+	Time int64 `json:"time"`
+
+	// Utilisation is the utilisation of the vCPU in percent.
+	Utilisation int `json:"utilisation"`
+}
+
+// NICDetails are the diagnostics of a single network interface.
+type NICDetails struct {
+	// MACAddress is the MAC address of the interface.
+	MACAddress string `json:"mac_address"`
+
+	// RxOctets is the number of bytes received.
+	RxOctets int64 `json:"rx_octets"`
+
+	// RxErrors is the number of receive errors.
+	RxErrors int64 `json:"rx_errors"`
+
+	// RxDrop is the number of received packets that were dropped.
+	RxDrop int64 `json:"rx_drop"`
+
+	// RxPackets is the number of packets received.
+	RxPackets int64 `json:"rx_packets"`
+
+	// RxRate is the receive rate in bytes per second.
+	RxRate int64 `json:"rx_rate"`
+
+	// TxOctets is the number of bytes transmitted.
+	TxOctets int64 `json:"tx_octets"`
+
+	// TxErrors is the number of transmit errors.
+	TxErrors int64 `json:"tx_errors"`
+
+	// TxDrop is the number of transmitted packets that were dropped.
+	TxDrop int64 `json:"tx_drop"`
+
+	// TxPackets is the number of packets transmitted.
+	TxPackets int64 `json:"tx_packets"`
+
+	// TxRate is the transmit rate in bytes per second.
+	TxRate int64 `json:"tx_rate"`
+}
+
+// DiskDetails are the diagnostics of a single disk.
+type DiskDetails struct {
+	// ReadBytes is the number of bytes read.
+	ReadBytes int64 `json:"read_bytes"`
+
+	// ReadRequests is the number of read requests.
+	ReadRequests int64 `json:"read_requests"`
+
+	// WriteBytes is the number of bytes written.
+	WriteBytes int64 `json:"write_bytes"`
+
+	// WriteRequests is the number of write requests.
+	WriteRequests int64 `json:"write_requests"`
+
+	// ErrorsCount is the number of disk errors.
+	ErrorsCount int64 `json:"errors_count"`
+}
+
+// MemoryDetails are the diagnostics of the memory of a server.
+type MemoryDetails struct {
+	// Maximum is the amount of memory provisioned for the server in MiB.
+	Maximum int64 `json:"maximum"`
+
+	// Used is the amount of memory used by the server in MiB.
+	Used int64 `json:"used"`
+}
@@ -0,0 +1,163 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/diagnostics"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+const (
+	serverID       = "b16ba811-199d-4ffd-8839-ba96c1185a67"
+	secondServerID = "9e5476bd-a4ec-4653-93d6-72c93aa682ba"
+	missingID      = "00000000-0000-0000-0000-000000000000"
+)
+
+// LegacyOutput is a sample response to a Get call with a microversion older
+// than 2.48.
+const LegacyOutput = `
+{
+    "cpu0_time": 17300000000,
+    "memory": 524288,
+    "vda_errors": -1,
+    "vda_read": 262144,
+    "vda_read_req": 112,
+    "vda_write": 5778432,
+    "vda_write_req": 488
+}
+`
+
+// StandardOutput is a sample response to a Get call with microversion 2.48.
+const StandardOutput = `
+{
+    "config_drive": true,
+    "cpu_details": [
+        {
+            "id": 0,
+            "time": 17300000000,
+            "utilisation": 15
+        }
+    ],
+    "disk_details": [
+        {
+            "errors_count": 1,
+            "read_bytes": 262144,
+            "read_requests": 112,
+            "write_bytes": 5778432,
+            "write_requests": 488
+        }
+    ],
+    "driver": "libvirt",
+    "hypervisor": "kvm",
+    "hypervisor_os": "ubuntu",
+    "memory_details": {
+        "maximum": 524288,
+        "used": 0
+    },
+    "nic_details": [
+        {
+            "mac_address": "01:23:45:67:89:ab",
+            "rx_drop": 200,
+            "rx_errors": 100,
+            "rx_octets": 2070139,
+            "rx_packets": 26701,
+            "rx_rate": 300,
+            "tx_drop": 500,
+            "tx_errors": 400,
+            "tx_octets": 140208,
+            "tx_packets": 662,
+            "tx_rate": 600
+        }
+    ],
+    "num_cpus": 1,
+    "num_disks": 1,
+    "num_nics": 1,
+    "state": "running",
+    "uptime": 46664
+}
+`
+
+// ExpectedLegacy is the LegacyDiagnostics expected from LegacyOutput.
+var ExpectedLegacy = diagnostics.LegacyDiagnostics{
+	"cpu0_time":     float64(17300000000),
+	"memory":        float64(524288),
+	"vda_errors":    float64(-1),
+	"vda_read":      float64(262144),
+	"vda_read_req":  float64(112),
+	"vda_write":     float64(5778432),
+	"vda_write_req": float64(488),
+}
+
+// ExpectedStandard is the Diagnostics expected from StandardOutput.
+var ExpectedStandard = diagnostics.Diagnostics{
+	State:        "running",
+	Driver:       "libvirt",
+	Hypervisor:   "kvm",
+	HypervisorOS: "ubuntu",
+	Uptime:       46664,
+	ConfigDrive:  true,
+	NumCPUs:      1,
+	NumNICs:      1,
+	NumDisks:     1,
+	CPUDetails: []diagnostics.CPUDetails{
+		{
+			ID:          0,
+			Time:        17300000000,
+			Utilisation: 15,
+		},
+	},
+	NICDetails: []diagnostics.NICDetails{
+		{
+			MACAddress: "01:23:45:67:89:ab",
+			RxOctets:   2070139,
+			RxErrors:   100,
+			RxDrop:     200,
+			RxPackets:  26701,
+			RxRate:     300,
+			TxOctets:   140208,
+			TxErrors:   400,
+			TxDrop:     500,
+			TxPackets:  662,
+			TxRate:     600,
+		},
+	},
+	DiskDetails: []diagnostics.DiskDetails{
+		{
+			ReadBytes:     262144,
+			ReadRequests:  112,
+			WriteBytes:    5778432,
+			WriteRequests: 488,
+			ErrorsCount:   1,
+		},
+	},
+	MemoryDetails: diagnostics.MemoryDetails{
+		Maximum: 524288,
+		Used:    0,
+	},
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request for the given server with the given body.
+func HandleGetSuccessfully(t *testing.T, id, body string) {
+	th.Mux.HandleFunc("/servers/"+id+"/diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	})
+}
+
+// HandleGetNotFound configures the test server to respond to a Get request
+// for the given server with a 404.
+func HandleGetNotFound(t *testing.T, id string) {
+	th.Mux.HandleFunc("/servers/"+id+"/diagnostics", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
@@ -0,0 +1,76 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/diagnostics"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+func TestGetLegacy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t, serverID, LegacyOutput)
+
+	actual, err := diagnostics.Get(client.ServiceClient(), serverID).ExtractLegacy()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedLegacy, actual)
+}
+
+func TestGetStandard(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t, serverID, StandardOutput)
+
+	c := client.ServiceClient()
+	c.Microversion = "2.48"
+
+	actual, err := diagnostics.Get(c, serverID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedStandard, actual)
+}
+
+func TestCollectStandard(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t, serverID, StandardOutput)
+	HandleGetSuccessfully(t, secondServerID, StandardOutput)
+	HandleGetNotFound(t, missingID)
+
+	c := client.ServiceClient()
+	c.Microversion = "2.48"
+
+	results := diagnostics.Collect(c, []string{serverID, missingID, secondServerID}, 2)
+	th.AssertEquals(t, 3, len(results))
+
+	th.AssertEquals(t, serverID, results[0].ServerID)
+	th.AssertNoErr(t, results[0].Err)
+	th.CheckDeepEquals(t, &ExpectedStandard, results[0].Diagnostics)
+
+	th.AssertEquals(t, missingID, results[1].ServerID)
+	if results[1].Err == nil {
+		t.Fatal("Expected an error for a missing server")
+	}
+	if results[1].Diagnostics != nil {
+		t.Fatal("Expected no diagnostics for a missing server")
+	}
+
+	th.AssertEquals(t, secondServerID, results[2].ServerID)
+	th.AssertNoErr(t, results[2].Err)
+	th.CheckDeepEquals(t, &ExpectedStandard, results[2].Diagnostics)
+}
+
+func TestCollectLegacy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t, serverID, LegacyOutput)
+
+	results := diagnostics.Collect(client.ServiceClient(), []string{serverID}, 0)
+	th.AssertEquals(t, 1, len(results))
+	th.AssertNoErr(t, results[0].Err)
+	th.CheckDeepEquals(t, ExpectedLegacy, results[0].Legacy)
+	if results[0].Diagnostics != nil {
+		t.Fatal("Expected no standard diagnostics with a legacy microversion")
+	}
+}
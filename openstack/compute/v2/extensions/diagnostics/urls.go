@@ -0,0 +1,7 @@
+package diagnostics
+
+import "github.com/chjlangzi/gophercloud"
+
+func getURL(client *gophercloud.ServiceClient, serverID string) string {
+	return client.ServiceURL("servers", serverID, "diagnostics")
+}
@@ -0,0 +1,70 @@
+package diagnostics
+
+import (
+	"sync"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/utils"
+)
+
+// standardMicroversion is the first microversion that returns diagnostics
+// following the standard schema.
+const standardMicroversion = "2.48"
+
+// ServerDiagnostics holds the diagnostics of a single server retrieved by
+// Collect. Exactly one of Diagnostics, Legacy and Err is set.
+type ServerDiagnostics struct {
+	// ServerID is the ID of the server.
+	ServerID string
+
+	// Diagnostics holds the diagnostics of the server when the client uses
+	// microversion 2.48 or later.
+	Diagnostics *Diagnostics
+
+	// Legacy holds the diagnostics of the server when the client uses a
+	// microversion older than 2.48.
+	Legacy LegacyDiagnostics
+
+	// Err is the error encountered while retrieving the diagnostics.
+	Err error
+}
+
+// Collect retrieves the diagnostics of many servers, running at most
+// concurrency requests at the same time. The format of the diagnostics is
+// selected by the microversion of the client. The results are returned in
+// the same order as serverIDs; a failure for one server does not prevent the
+// others from being collected.
+func Collect(client *gophercloud.ServiceClient, serverIDs []string, concurrency int) []ServerDiagnostics {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	standard := utils.MicroversionAtLeast(client.Microversion, standardMicroversion)
+	results := make([]ServerDiagnostics, len(serverIDs))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, serverID := range serverIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, serverID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result := ServerDiagnostics{ServerID: serverID}
+			r := Get(client, serverID)
+			if standard {
+				result.Diagnostics, result.Err = r.Extract()
+			} else {
+				result.Legacy, result.Err = r.ExtractLegacy()
+			}
+			if result.Err != nil {
+				result.Diagnostics, result.Legacy = nil, nil
+			}
+			results[i] = result
+		}(i, serverID)
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,61 @@
+/*
+Package externalevents provides the ability to send external events, such as
+port or volume changes, to the OpenStack Compute service. Sending events is
+usually restricted to administrators and to the Networking and Block Storage
+services.
+
+Example to Send External Events
+
+	createOpts := externalevents.CreateOpts{
+		Events: []externalevents.EventOpts{
+			{
+				Name:       externalevents.NetworkVIFPlugged,
+				ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+				Tag:        "0b7a8b2c-1b0e-4a46-a8b1-a4f0ad2c0c1d",
+			},
+		},
+	}
+
+	events, err := externalevents.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, event := range events {
+		fmt.Printf("%s for server %s: %d\n", event.Name, event.ServerUUID, event.Code)
+	}
+
+Example to Send External Events and Report the Rejected Ones
+
+	computeClient.Microversion = "2.51"
+
+	_, err := externalevents.Send(computeClient,
+		externalevents.EventOpts{
+			Name:       externalevents.VolumeExtended,
+			ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+			Tag:        "a26887c6-c47b-4654-abb5-dfadf7d3f803",
+		},
+		externalevents.EventOpts{
+			Name:       externalevents.VolumeExtended,
+			ServerUUID: "d8a5a2ef-0d1e-4a5b-8f7e-6e1b2c7d3f10",
+			Tag:        "a26887c6-c47b-4654-abb5-dfadf7d3f803",
+		},
+	)
+	if err, ok := err.(externalevents.ErrEventsRejected); ok {
+		for _, event := range err.Rejected {
+			fmt.Printf("Server %s rejected %s with code %d\n", event.ServerUUID, event.Name, event.Code)
+		}
+	} else if err != nil {
+		panic(err)
+	}
+
+Example to Sync the Power State of a Server
+
+	computeClient.Microversion = "2.76"
+
+	err := externalevents.SyncPowerState(computeClient, "3df201cf-2451-44f2-8d25-a4ca826fc1f3", false)
+	if err != nil {
+		panic(err)
+	}
+*/
+package externalevents
@@ -0,0 +1,25 @@
+package externalevents
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrEventsRejected is returned by Send when the Compute service rejected
+// some of the events.
+type ErrEventsRejected struct {
+	gophercloud.BaseError
+
+	// Rejected are the events that were not accepted.
+	Rejected []Event
+}
+
+func (e ErrEventsRejected) Error() string {
+	rejected := make([]string, len(e.Rejected))
+	for i, event := range e.Rejected {
+		rejected[i] = fmt.Sprintf("%s for server %s (code %d)", event.Name, event.ServerUUID, event.Code)
+	}
+	return fmt.Sprintf("%d external events were rejected: %s", len(e.Rejected), strings.Join(rejected, ", "))
+}
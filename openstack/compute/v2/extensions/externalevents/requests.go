@@ -0,0 +1,127 @@
+package externalevents
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// EventName is the name of an external event.
+type EventName string
+
+const (
+	// NetworkChanged notifies that the network configuration of a port of the
+	// server has changed.
+	NetworkChanged EventName = "network-changed"
+
+	// NetworkVIFPlugged notifies that a port of the server has been plugged.
+	NetworkVIFPlugged EventName = "network-vif-plugged"
+
+	// NetworkVIFUnplugged notifies that a port of the server has been
+	// unplugged.
+	NetworkVIFUnplugged EventName = "network-vif-unplugged"
+
+	// NetworkVIFDeleted notifies that a port of the server has been deleted.
+	NetworkVIFDeleted EventName = "network-vif-deleted"
+
+	// VolumeExtended notifies that an attached volume has been extended. The
+	// tag is the volume ID. Requires microversion 2.51 or later.
+	VolumeExtended EventName = "volume-extended"
+
+	// PowerUpdate notifies that the power state of the server has been
+	// changed outside of the Compute service. The tag is PowerOn or PowerOff.
+	// Requires microversion 2.76 or later.
+	PowerUpdate EventName = "power-update"
+
+	// AcceleratorRequestBound notifies that an accelerator request has been
+	// bound. The tag is the accelerator request ID.
+	// Requires microversion 2.82 or later.
+	AcceleratorRequestBound EventName = "accelerator-request-bound"
+)
+
+// EventStatus is the status of an external event.
+type EventStatus string
+
+const (
+	// StatusCompleted is the default status of an event.
+	StatusCompleted EventStatus = "completed"
+
+	// StatusFailed reports that the operation behind the event failed.
+	StatusFailed EventStatus = "failed"
+
+	// StatusInProgress reports that the operation behind the event is still
+	// running.
+	StatusInProgress EventStatus = "in-progress"
+)
+
+const (
+	// PowerOn is the tag of a PowerUpdate event for a server that has been
+	// powered on.
+	PowerOn = "POWER_ON"
+
+	// PowerOff is the tag of a PowerUpdate event for a server that has been
+	// powered off.
+	PowerOff = "POWER_OFF"
+)
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToExternalEventCreateMap() (map[string]interface{}, error)
+}
+
+// EventOpts specifies a single external event.
+type EventOpts struct {
+	// Name is the name of the event.
+	Name EventName `json:"name" required:"true"`
+
+	// ServerUUID is the ID of the server the event is about.
+	ServerUUID string `json:"server_uuid" required:"true"`
+
+	// Status is the status of the event. It defaults to StatusCompleted.
+	Status EventStatus `json:"status,omitempty"`
+
+	// Tag identifies the resource the event is about, such as a port ID for
+	// network events or a volume ID for VolumeExtended.
+	Tag string `json:"tag,omitempty"`
+}
+
+// CreateOpts specifies the external events to send.
+type CreateOpts struct {
+	// Events is the list of events to send.
+	Events []EventOpts
+}
+
+// ToExternalEventCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToExternalEventCreateMap() (map[string]interface{}, error) {
+	if len(opts.Events) == 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "externalevents.CreateOpts.Events"
+		return nil, err
+	}
+
+	events := make([]map[string]interface{}, len(opts.Events))
+	for i, event := range opts.Events {
+		b, err := gophercloud.BuildRequestBody(event, "")
+		if err != nil {
+			return nil, err
+		}
+		events[i] = b
+	}
+
+	return map[string]interface{}{"events": events}, nil
+}
+
+// Create sends external events to the Compute service. The response is
+// successful as long as at least one event was accepted; use the Code of each
+// returned Event, or Send, to find out which events were rejected. If no event
+// was accepted, the Compute service responds with a 404 error.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToExternalEventCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(createURL(client), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 207},
+	})
+	return
+}
@@ -0,0 +1,47 @@
+package externalevents
+
+import (
+	"net/http"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// Event is the result of sending a single external event.
+type Event struct {
+	// Name is the name of the event.
+	Name EventName `json:"name"`
+
+	// ServerUUID is the ID of the server the event is about.
+	ServerUUID string `json:"server_uuid"`
+
+	// Status is the status of the event.
+	Status EventStatus `json:"status"`
+
+	// Tag identifies the resource the event is about.
+	Tag string `json:"tag"`
+
+	// Code is the result of the event: 200 if it was accepted, 400 if it was
+	// invalid, 404 if the server was not found and 422 if the server is not
+	// yet assigned to a host.
+	Code int `json:"code"`
+}
+
+// Accepted reports whether the Compute service accepted the event.
+func (e Event) Accepted() bool {
+	return e.Code == http.StatusOK
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a slice of Events.
+type CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CreateResult as a slice of Events.
+func (r CreateResult) Extract() ([]Event, error) {
+	var s struct {
+		Events []Event `json:"events"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Events, err
+}
@@ -0,0 +1,2 @@
+// compute_extensions_externalevents_v2
+package testing
@@ -0,0 +1,180 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/externalevents"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+// CreateRequest is the expected request body of a Create call.
+const CreateRequest = `
+{
+	"events": [
+		{
+			"name": "network-vif-plugged",
+			"server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+			"tag": "0b7a8b2c-1b0e-4a46-a8b1-a4f0ad2c0c1d"
+		},
+		{
+			"name": "network-vif-plugged",
+			"server_uuid": "d8a5a2ef-0d1e-4a5b-8f7e-6e1b2c7d3f10",
+			"status": "failed",
+			"tag": "6b0c8a3c-2b7e-4f0e-9a3f-7f1ad5c0e2b4"
+		}
+	]
+}
+`
+
+// CreateOutput is a sample response to a Create call that was accepted for
+// all events.
+const CreateOutput = `
+{
+	"events": [
+		{
+			"name": "network-vif-plugged",
+			"server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+			"status": "completed",
+			"tag": "0b7a8b2c-1b0e-4a46-a8b1-a4f0ad2c0c1d",
+			"code": 200
+		},
+		{
+			"name": "network-vif-plugged",
+			"server_uuid": "d8a5a2ef-0d1e-4a5b-8f7e-6e1b2c7d3f10",
+			"status": "failed",
+			"tag": "6b0c8a3c-2b7e-4f0e-9a3f-7f1ad5c0e2b4",
+			"code": 200
+		}
+	]
+}
+`
+
+// PartialOutput is a sample response to a Create call where the second
+// server was not found.
+const PartialOutput = `
+{
+	"events": [
+		{
+			"name": "network-vif-plugged",
+			"server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+			"status": "completed",
+			"tag": "0b7a8b2c-1b0e-4a46-a8b1-a4f0ad2c0c1d",
+			"code": 200
+		},
+		{
+			"name": "network-vif-plugged",
+			"server_uuid": "d8a5a2ef-0d1e-4a5b-8f7e-6e1b2c7d3f10",
+			"status": "failed",
+			"tag": "6b0c8a3c-2b7e-4f0e-9a3f-7f1ad5c0e2b4",
+			"code": 404
+		}
+	]
+}
+`
+
+// FirstEvent is the first event of CreateOutput and PartialOutput.
+var FirstEvent = externalevents.Event{
+	Name:       externalevents.NetworkVIFPlugged,
+	ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+	Status:     externalevents.StatusCompleted,
+	Tag:        "0b7a8b2c-1b0e-4a46-a8b1-a4f0ad2c0c1d",
+	Code:       200,
+}
+
+// SecondEvent is the second event of CreateOutput.
+var SecondEvent = externalevents.Event{
+	Name:       externalevents.NetworkVIFPlugged,
+	ServerUUID: "d8a5a2ef-0d1e-4a5b-8f7e-6e1b2c7d3f10",
+	Status:     externalevents.StatusFailed,
+	Tag:        "6b0c8a3c-2b7e-4f0e-9a3f-7f1ad5c0e2b4",
+	Code:       200,
+}
+
+// RejectedEvent is the second event of PartialOutput.
+var RejectedEvent = externalevents.Event{
+	Name:       externalevents.NetworkVIFPlugged,
+	ServerUUID: "d8a5a2ef-0d1e-4a5b-8f7e-6e1b2c7d3f10",
+	Status:     externalevents.StatusFailed,
+	Tag:        "6b0c8a3c-2b7e-4f0e-9a3f-7f1ad5c0e2b4",
+	Code:       404,
+}
+
+// CreateEvents are the events sent by the Create tests.
+var CreateEvents = []externalevents.EventOpts{
+	{
+		Name:       externalevents.NetworkVIFPlugged,
+		ServerUUID: "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+		Tag:        "0b7a8b2c-1b0e-4a46-a8b1-a4f0ad2c0c1d",
+	},
+	{
+		Name:       externalevents.NetworkVIFPlugged,
+		ServerUUID: "d8a5a2ef-0d1e-4a5b-8f7e-6e1b2c7d3f10",
+		Status:     externalevents.StatusFailed,
+		Tag:        "6b0c8a3c-2b7e-4f0e-9a3f-7f1ad5c0e2b4",
+	},
+}
+
+// HandleCreateSuccessfully configures the test server to respond to a Create
+// request with the given status code and body.
+func HandleCreateSuccessfully(t *testing.T, code int, output string) {
+	th.Mux.HandleFunc("/os-server-external-events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(code)
+		fmt.Fprint(w, output)
+	})
+}
+
+// HandlePowerUpdateSuccessfully configures the test server to respond to a
+// Create request with a power-update event.
+func HandlePowerUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-server-external-events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+		{
+			"events": [
+				{
+					"name": "power-update",
+					"server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+					"tag": "POWER_OFF"
+				}
+			]
+		}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+		{
+			"events": [
+				{
+					"name": "power-update",
+					"server_uuid": "3df201cf-2451-44f2-8d25-a4ca826fc1f3",
+					"status": "completed",
+					"tag": "POWER_OFF",
+					"code": 200
+				}
+			]
+		}
+		`)
+	})
+}
+
+// HandleCreateNotFound configures the test server to respond to a Create
+// request as if none of the servers exist.
+func HandleCreateNotFound(t *testing.T) {
+	th.Mux.HandleFunc("/os-server-external-events", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"itemNotFound": {"code": 404, "message": "No instances found for any event"}}`)
+	})
+}
@@ -0,0 +1,93 @@
+package testing
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/externalevents"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t, http.StatusOK, CreateOutput)
+
+	actual, err := externalevents.Create(client.ServiceClient(), externalevents.CreateOpts{Events: CreateEvents}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []externalevents.Event{FirstEvent, SecondEvent}, actual)
+}
+
+func TestCreatePartial(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t, http.StatusMultiStatus, PartialOutput)
+
+	actual, err := externalevents.Create(client.ServiceClient(), externalevents.CreateOpts{Events: CreateEvents}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []externalevents.Event{FirstEvent, RejectedEvent}, actual)
+	th.AssertEquals(t, true, actual[0].Accepted())
+	th.AssertEquals(t, false, actual[1].Accepted())
+}
+
+func TestCreateRequiresEvents(t *testing.T) {
+	err := externalevents.Create(client.ServiceClient(), externalevents.CreateOpts{}).Err
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+
+	err = externalevents.Create(client.ServiceClient(), externalevents.CreateOpts{
+		Events: []externalevents.EventOpts{{Name: externalevents.NetworkChanged}},
+	}).Err
+	if _, ok := err.(gophercloud.ErrMissingInput); !ok {
+		t.Fatalf("expected ErrMissingInput, got %v", err)
+	}
+}
+
+func TestSend(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t, http.StatusOK, CreateOutput)
+
+	accepted, err := externalevents.Send(client.ServiceClient(), CreateEvents...)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(accepted))
+}
+
+func TestSendPartial(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateSuccessfully(t, http.StatusMultiStatus, PartialOutput)
+
+	accepted, err := externalevents.Send(client.ServiceClient(), CreateEvents...)
+	th.CheckDeepEquals(t, []externalevents.Event{FirstEvent}, accepted)
+
+	rejected, ok := err.(externalevents.ErrEventsRejected)
+	if !ok {
+		t.Fatalf("expected ErrEventsRejected, got %v", err)
+	}
+	th.CheckDeepEquals(t, []externalevents.Event{RejectedEvent}, rejected.Rejected)
+	th.AssertEquals(t, "1 external events were rejected: network-vif-plugged for server d8a5a2ef-0d1e-4a5b-8f7e-6e1b2c7d3f10 (code 404)", rejected.Error())
+}
+
+func TestSendNotFound(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateNotFound(t)
+
+	_, err := externalevents.Send(client.ServiceClient(), CreateEvents...)
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("expected ErrDefault404, got %v", err)
+	}
+}
+
+func TestSyncPowerState(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePowerUpdateSuccessfully(t)
+
+	err := externalevents.SyncPowerState(client.ServiceClient(), "3df201cf-2451-44f2-8d25-a4ca826fc1f3", false)
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,47 @@
+package externalevents
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// Send sends the given events and returns the events accepted by the Compute
+// service. If some events were rejected, it also returns an
+// ErrEventsRejected that lists them.
+func Send(client *gophercloud.ServiceClient, events ...EventOpts) ([]Event, error) {
+	results, err := Create(client, CreateOpts{Events: events}).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var accepted, rejected []Event
+	for _, event := range results {
+		if event.Accepted() {
+			accepted = append(accepted, event)
+		} else {
+			rejected = append(rejected, event)
+		}
+	}
+
+	if len(rejected) > 0 {
+		return accepted, ErrEventsRejected{Rejected: rejected}
+	}
+
+	return accepted, nil
+}
+
+// SyncPowerState notifies the Compute service that the power state of a
+// server was changed outside of it, for example by a baremetal management
+// controller. Requires microversion 2.76 or later.
+func SyncPowerState(client *gophercloud.ServiceClient, serverID string, powerOn bool) error {
+	tag := PowerOff
+	if powerOn {
+		tag = PowerOn
+	}
+
+	_, err := Send(client, EventOpts{
+		Name:       PowerUpdate,
+		ServerUUID: serverID,
+		Tag:        tag,
+	})
+	return err
+}
@@ -47,5 +47,38 @@ Example of Show Hypervisor Uptime
 
 	fmt.Printf("%+v\n", hypervisorUptime)
 
+Example of Searching Hypervisors by Hostname Pattern
+
+	allPages, err := hypervisors.Search(computeClient, "compute-1").AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allHypervisors, err := hypervisors.ExtractHypervisorSummaries(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, hypervisor := range allHypervisors {
+		fmt.Printf("%s: %s\n", hypervisor.HypervisorHostname, hypervisor.State)
+	}
+
+Example of Listing the Servers Running on Hypervisors
+
+	allPages, err := hypervisors.ListServers(computeClient, "compute-1").AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allHypervisors, err := hypervisors.ExtractHypervisorSummaries(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, hypervisor := range allHypervisors {
+		for _, server := range hypervisor.Servers {
+			fmt.Printf("%s: %s\n", hypervisor.HypervisorHostname, server.UUID)
+		}
+	}
 */
 package hypervisors
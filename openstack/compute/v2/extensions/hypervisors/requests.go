@@ -1,9 +1,11 @@
 package hypervisors
 
 import (
+	"net/url"
 	"strconv"
 
 	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/utils"
 	"github.com/chjlangzi/gophercloud/pagination"
 )
 
@@ -39,3 +41,39 @@ func GetUptime(client *gophercloud.ServiceClient, hypervisorID int) (r UptimeRes
 	})
 	return
 }
+
+// Search makes a request against the API to find the hypervisors whose
+// hostname contains the given pattern. With microversion 2.53 or later the
+// hypervisor_hostname_pattern query parameter is used instead of the
+// deprecated search URL.
+func Search(client *gophercloud.ServiceClient, pattern string) pagination.Pager {
+	return listSummaries(client, pattern, false)
+}
+
+// ListServers makes a request against the API to list the hypervisors whose
+// hostname contains the given pattern along with the servers running on
+// them. With microversion 2.53 or later the with_servers query parameter is
+// used instead of the deprecated servers URL.
+func ListServers(client *gophercloud.ServiceClient, pattern string) pagination.Pager {
+	return listSummaries(client, pattern, true)
+}
+
+func listSummaries(client *gophercloud.ServiceClient, pattern string, withServers bool) pagination.Pager {
+	var u string
+	switch {
+	case utils.MicroversionAtLeast(client.Microversion, "2.53"):
+		q := url.Values{"hypervisor_hostname_pattern": []string{pattern}}
+		if withServers {
+			q.Set("with_servers", "true")
+		}
+		u = hypervisorsListURL(client) + "?" + q.Encode()
+	case withServers:
+		u = hypervisorsServersURL(client, pattern)
+	default:
+		u = hypervisorsSearchURL(client, pattern)
+	}
+
+	return pagination.NewPager(client, u, func(r pagination.PageResult) pagination.Page {
+		return HypervisorSummaryPage{pagination.SinglePageBase(r)}
+	})
+}
@@ -3,6 +3,7 @@ package hypervisors
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/chjlangzi/gophercloud"
 	"github.com/chjlangzi/gophercloud/pagination"
@@ -288,3 +289,83 @@ func (r UptimeResult) Extract() (*Uptime, error) {
 	err := r.ExtractInto(&s)
 	return &s.Uptime, err
 }
+
+// HypervisorServer is a server running on a hypervisor.
+type HypervisorServer struct {
+	// Name is the name of the server.
+	Name string `json:"name"`
+
+	// UUID is the ID of the server.
+	UUID string `json:"uuid"`
+}
+
+// HypervisorSummary is the abbreviated representation of a hypervisor
+// returned by Search and ListServers.
+type HypervisorSummary struct {
+	// ID is the ID of the hypervisor. It is an integer rendered as a string
+	// before microversion 2.53 and a UUID afterwards.
+	ID string `json:"-"`
+
+	// HypervisorHostname is the hostname of the hypervisor.
+	HypervisorHostname string `json:"hypervisor_hostname"`
+
+	// State is the state of the hypervisor, either "up" or "down".
+	State string `json:"state"`
+
+	// Status is the status of the hypervisor, either "enabled" or
+	// "disabled".
+	Status string `json:"status"`
+
+	// Servers are the servers running on the hypervisor. It is only set by
+	// ListServers.
+	Servers []HypervisorServer `json:"servers"`
+}
+
+func (r *HypervisorSummary) UnmarshalJSON(b []byte) error {
+	type tmp HypervisorSummary
+	var s struct {
+		tmp
+		ID interface{} `json:"id"`
+	}
+
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+
+	*r = HypervisorSummary(s.tmp)
+
+	switch t := s.ID.(type) {
+	case nil:
+	case float64:
+		r.ID = strconv.FormatInt(int64(t), 10)
+	case string:
+		r.ID = t
+	default:
+		return fmt.Errorf("ID has unexpected type: %T", t)
+	}
+
+	return nil
+}
+
+// HypervisorSummaryPage represents a single page of the hypervisors returned
+// by Search or ListServers.
+type HypervisorSummaryPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a HypervisorSummaryPage is empty.
+func (page HypervisorSummaryPage) IsEmpty() (bool, error) {
+	va, err := ExtractHypervisorSummaries(page)
+	return len(va) == 0, err
+}
+
+// ExtractHypervisorSummaries interprets a page of results as a slice of
+// HypervisorSummaries.
+func ExtractHypervisorSummaries(p pagination.Page) ([]HypervisorSummary, error) {
+	var h struct {
+		Hypervisors []HypervisorSummary `json:"hypervisors"`
+	}
+	err := (p.(HypervisorSummaryPage)).ExtractInto(&h)
+	return h.Hypervisors, err
+}
@@ -263,3 +263,92 @@ func HandleHypervisorUptimeSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, HypervisorUptimeBody)
 	})
 }
+
+// HypervisorSearchBody is sample response to the Search call.
+const HypervisorSearchBody = `
+{
+    "hypervisors": [
+        {
+            "hypervisor_hostname": "fake-mini",
+            "id": 1,
+            "state": "up",
+            "status": "enabled"
+        }
+    ]
+}
+`
+
+// HypervisorServersBody is sample response to the ListServers call with
+// microversion 2.53.
+const HypervisorServersBody = `
+{
+    "hypervisors": [
+        {
+            "hypervisor_hostname": "fake-mini",
+            "id": "b1e43b5f-eec1-44e0-9f10-7b4945c0226d",
+            "state": "up",
+            "status": "enabled",
+            "servers": [
+                {
+                    "name": "test_server1",
+                    "uuid": "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+                },
+                {
+                    "name": "test_server2",
+                    "uuid": "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+                }
+            ]
+        }
+    ]
+}
+`
+
+var (
+	HypervisorSearchFake = hypervisors.HypervisorSummary{
+		HypervisorHostname: "fake-mini",
+		ID:                 "1",
+		State:              "up",
+		Status:             "enabled",
+	}
+
+	HypervisorServersFake = hypervisors.HypervisorSummary{
+		HypervisorHostname: "fake-mini",
+		ID:                 "b1e43b5f-eec1-44e0-9f10-7b4945c0226d",
+		State:              "up",
+		Status:             "enabled",
+		Servers: []hypervisors.HypervisorServer{
+			{
+				Name: "test_server1",
+				UUID: "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa",
+			},
+			{
+				Name: "test_server2",
+				UUID: "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb",
+			},
+		},
+	}
+)
+
+func HandleHypervisorSearchSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/os-hypervisors/fake/search", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, HypervisorSearchBody)
+	})
+}
+
+func HandleHypervisorServersSuccessfully(t *testing.T) {
+	testhelper.Mux.HandleFunc("/os-hypervisors", func(w http.ResponseWriter, r *http.Request) {
+		testhelper.TestMethod(t, r, "GET")
+		testhelper.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		testhelper.TestFormValues(t, r, map[string]string{
+			"hypervisor_hostname_pattern": "fake",
+			"with_servers":                "true",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, HypervisorServersBody)
+	})
+}
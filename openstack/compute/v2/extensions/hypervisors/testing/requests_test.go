@@ -87,3 +87,32 @@ func TestHypervisorsUptime(t *testing.T) {
 	testhelper.AssertNoErr(t, err)
 	testhelper.CheckDeepEquals(t, &expected, actual)
 }
+
+func TestSearchHypervisors(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleHypervisorSearchSuccessfully(t)
+
+	allPages, err := hypervisors.Search(client.ServiceClient(), "fake").AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := hypervisors.ExtractHypervisorSummaries(allPages)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []hypervisors.HypervisorSummary{HypervisorSearchFake}, actual)
+}
+
+func TestListHypervisorServers(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleHypervisorServersSuccessfully(t)
+
+	c := client.ServiceClient()
+	c.Microversion = "2.53"
+
+	allPages, err := hypervisors.ListServers(c, "fake").AllPages()
+	testhelper.AssertNoErr(t, err)
+
+	actual, err := hypervisors.ExtractHypervisorSummaries(allPages)
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, []hypervisors.HypervisorSummary{HypervisorServersFake}, actual)
+}
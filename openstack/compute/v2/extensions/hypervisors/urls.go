@@ -1,6 +1,10 @@
 package hypervisors
 
-import "github.com/chjlangzi/gophercloud"
+import (
+	"net/url"
+
+	"github.com/chjlangzi/gophercloud"
+)
 
 func hypervisorsListDetailURL(c *gophercloud.ServiceClient) string {
 	return c.ServiceURL("os-hypervisors", "detail")
@@ -17,3 +21,15 @@ func hypervisorsGetURL(c *gophercloud.ServiceClient, hypervisorID string) string
 func hypervisorsUptimeURL(c *gophercloud.ServiceClient, hypervisorID string) string {
 	return c.ServiceURL("os-hypervisors", hypervisorID, "uptime")
 }
+
+func hypervisorsListURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("os-hypervisors")
+}
+
+func hypervisorsSearchURL(c *gophercloud.ServiceClient, pattern string) string {
+	return c.ServiceURL("os-hypervisors", url.PathEscape(pattern), "search")
+}
+
+func hypervisorsServersURL(c *gophercloud.ServiceClient, pattern string) string {
+	return c.ServiceURL("os-hypervisors", url.PathEscape(pattern), "servers")
+}
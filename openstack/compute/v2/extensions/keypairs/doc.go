@@ -4,7 +4,7 @@ servers with a specified key pair.
 
 Example to List Key Pairs
 
-	allPages, err := keypairs.List(computeClient).AllPages()
+	allPages, err := keypairs.List(computeClient, nil).AllPages()
 	if err != nil {
 		panic(err)
 	}
@@ -18,6 +18,28 @@ Example to List Key Pairs
 		fmt.Printf("%+v\n", kp)
 	}
 
+Example to List Key Pairs of Another User, One Page at a Time
+
+	computeClient.Microversion = "2.35"
+
+	listOpts := keypairs.ListOpts{
+		UserID: "user-id",
+		Limit:  20,
+	}
+
+	err := keypairs.List(computeClient, listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		kps, err := keypairs.ExtractKeyPairs(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, kp := range kps {
+			fmt.Printf("%+v\n", kp)
+		}
+
+		return true, nil
+	})
+
 Example to Create a Key Pair
 
 	createOpts := keypairs.CreateOpts{
@@ -43,9 +65,47 @@ Example to Import a Key Pair
 		panic(err)
 	}
 
+Example to Generate a Key Pair Locally and Import It
+
+	generateOpts := keypairs.GenerateOpts{
+		Name:      "keypair-name",
+		Algorithm: keypairs.AlgorithmRSA,
+	}
+
+	keypair, err := keypairs.GenerateAndImport(computeClient, generateOpts)
+	if err != nil {
+		panic(err)
+	}
+
+	// keypair.PrivateKey holds the private key in OpenSSH format.
+	err = ioutil.WriteFile("id_rsa", []byte(keypair.PrivateKey), 0600)
+	if err != nil {
+		panic(err)
+	}
+
+	// RSA keys can decrypt the administrative password of a server.
+	privateKey, _ := keypair.RSAKey()
+	password, err := servers.GetPassword(computeClient, serverID).ExtractPassword(privateKey)
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Key Pair of Another User
+
+	computeClient.Microversion = "2.10"
+
+	deleteOpts := keypairs.DeleteOpts{
+		UserID: "user-id",
+	}
+
+	err := keypairs.Delete(computeClient, "keypair-name", deleteOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Key Pair
 
-	err := keypairs.Delete(computeClient, "keypair-name").ExtractErr()
+	err := keypairs.Delete(computeClient, "keypair-name", nil).ExtractErr()
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,91 @@
+package keypairs
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
+)
+
+// marshalOpenSSHPrivateKey encodes an unencrypted private key in the
+// "openssh-key-v1" format written by ssh-keygen. It is implemented here
+// rather than with ssh.MarshalPrivateKey so that the package builds against
+// the older golang.org/x/crypto releases used by CI.
+func marshalOpenSSHPrivateKey(key crypto.PrivateKey, comment string) (*pem.Block, error) {
+	var pub ssh.PublicKey
+	var keyType string
+	var keyFields []byte
+
+	switch k := key.(type) {
+	case ed25519.PrivateKey:
+		public := k.Public().(ed25519.PublicKey)
+		p, err := ssh.NewPublicKey(public)
+		if err != nil {
+			return nil, err
+		}
+		pub = p
+		keyType = ssh.KeyAlgoED25519
+		keyFields = ssh.Marshal(struct {
+			Pub  []byte
+			Priv []byte
+		}{[]byte(public), []byte(k)})
+	case *rsa.PrivateKey:
+		p, err := ssh.NewPublicKey(&k.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		pub = p
+		keyType = ssh.KeyAlgoRSA
+		k.Precompute()
+		keyFields = ssh.Marshal(struct {
+			N    *big.Int
+			E    *big.Int
+			D    *big.Int
+			Iqmp *big.Int
+			P    *big.Int
+			Q    *big.Int
+		}{k.N, big.NewInt(int64(k.E)), k.D, k.Precomputed.Qinv, k.Primes[0], k.Primes[1]})
+	default:
+		return nil, fmt.Errorf("Unsupported private key type: %T", key)
+	}
+
+	var check [4]byte
+	if _, err := rand.Read(check[:]); err != nil {
+		return nil, err
+	}
+	checkInt := binary.BigEndian.Uint32(check[:])
+
+	privSection := ssh.Marshal(struct {
+		Check1  uint32
+		Check2  uint32
+		KeyType string
+		Rest    []byte `ssh:"rest"`
+	}{checkInt, checkInt, keyType, keyFields})
+	privSection = append(privSection, ssh.Marshal(struct{ Comment string }{comment})...)
+
+	// The private section is padded to the cipher block size, which is 8 for
+	// the "none" cipher.
+	for i := 1; len(privSection)%8 != 0; i++ {
+		privSection = append(privSection, byte(i))
+	}
+
+	body := ssh.Marshal(struct {
+		CipherName   string
+		KdfName      string
+		KdfOpts      string
+		NumKeys      uint32
+		PubKey       []byte
+		PrivKeyBlock []byte
+	}{"none", "none", "", 1, pub.Marshal(), privSection})
+
+	return &pem.Block{
+		Type:  "OPENSSH PRIVATE KEY",
+		Bytes: append([]byte("openssh-key-v1\x00"), body...),
+	}, nil
+}
@@ -31,10 +31,45 @@ func (opts CreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
 	return base, nil
 }
 
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToKeyPairListQuery() (string, error)
+}
+
+// ListOpts enables listing KeyPairs of another user and paging through them.
+type ListOpts struct {
+	// UserID lists the KeyPairs of the given user.
+	// Requires admin privileges and microversion 2.10 or later.
+	UserID string `q:"user_id"`
+
+	// Limit is the maximum number of KeyPairs to return per page.
+	// Requires microversion 2.35 or later.
+	Limit int `q:"limit"`
+
+	// Marker is the name of the last-seen KeyPair.
+	// Requires microversion 2.35 or later.
+	Marker string `q:"marker"`
+}
+
+// ToKeyPairListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToKeyPairListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
 // List returns a Pager that allows you to iterate over a collection of KeyPairs.
-func List(client *gophercloud.ServiceClient) pagination.Pager {
-	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
-		return KeyPairPage{pagination.SinglePageBase(r)}
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToKeyPairListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return KeyPairPage{pagination.LinkedPageBase{PageResult: r}}
 	})
 }
 
@@ -52,6 +87,14 @@ type CreateOpts struct {
 	// PublicKey [optional] is a pregenerated OpenSSH-formatted public key.
 	// If provided, this key will be imported and no new key will be created.
 	PublicKey string `json:"public_key,omitempty"`
+
+	// Type [optional] is the type of the KeyPair, either ssh or x509.
+	// Requires microversion 2.2 or later.
+	Type KeyPairType `json:"type,omitempty"`
+
+	// UserID [optional] creates the KeyPair for another user.
+	// Requires admin privileges and microversion 2.10 or later.
+	UserID string `json:"user_id,omitempty"`
 }
 
 // ToKeyPairCreateMap constructs a request body from CreateOpts.
@@ -73,14 +116,70 @@ func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r Create
 	return
 }
 
+// GetOptsBuilder allows extensions to add additional parameters to the
+// Get request.
+type GetOptsBuilder interface {
+	ToKeyPairGetQuery() (string, error)
+}
+
+// GetOpts enables retrieving a KeyPair of another user.
+type GetOpts struct {
+	// UserID is the user who owns the KeyPair.
+	// Requires admin privileges and microversion 2.10 or later.
+	UserID string `q:"user_id"`
+}
+
+// ToKeyPairGetQuery formats a GetOpts into a query string.
+func (opts GetOpts) ToKeyPairGetQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
 // Get returns public data about a previously uploaded KeyPair.
-func Get(client *gophercloud.ServiceClient, name string) (r GetResult) {
-	_, r.Err = client.Get(getURL(client, name), &r.Body, nil)
+func Get(client *gophercloud.ServiceClient, name string, opts GetOptsBuilder) (r GetResult) {
+	url := getURL(client, name)
+	if opts != nil {
+		query, err := opts.ToKeyPairGetQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Get(url, &r.Body, nil)
 	return
 }
 
+// DeleteOptsBuilder allows extensions to add additional parameters to the
+// Delete request.
+type DeleteOptsBuilder interface {
+	ToKeyPairDeleteQuery() (string, error)
+}
+
+// DeleteOpts enables deleting a KeyPair of another user.
+type DeleteOpts struct {
+	// UserID is the user who owns the KeyPair.
+	// Requires admin privileges and microversion 2.10 or later.
+	UserID string `q:"user_id"`
+}
+
+// ToKeyPairDeleteQuery formats a DeleteOpts into a query string.
+func (opts DeleteOpts) ToKeyPairDeleteQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
 // Delete requests the deletion of a previous stored KeyPair from the server.
-func Delete(client *gophercloud.ServiceClient, name string) (r DeleteResult) {
-	_, r.Err = client.Delete(deleteURL(client, name), nil)
+func Delete(client *gophercloud.ServiceClient, name string, opts DeleteOptsBuilder) (r DeleteResult) {
+	url := deleteURL(client, name)
+	if opts != nil {
+		query, err := opts.ToKeyPairDeleteQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+	_, r.Err = client.Delete(url, nil)
 	return
 }
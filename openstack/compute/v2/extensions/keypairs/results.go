@@ -5,6 +5,17 @@ import (
 	"github.com/chjlangzi/gophercloud/pagination"
 )
 
+// KeyPairType is the type of a KeyPair.
+type KeyPairType string
+
+const (
+	// TypeSSH is an OpenSSH key pair. It is the default type.
+	TypeSSH KeyPairType = "ssh"
+
+	// TypeX509 is an x509 certificate, used by Windows servers.
+	TypeX509 KeyPairType = "x509"
+)
+
 // KeyPair is an SSH key known to the OpenStack Cloud that is available to be
 // injected into servers.
 type KeyPair struct {
@@ -27,13 +38,31 @@ type KeyPair struct {
 
 	// UserID is the user who owns this KeyPair.
 	UserID string `json:"user_id"`
+
+	// Type is the type of this KeyPair. It is only reported with
+	// microversion 2.2 or later.
+	Type KeyPairType `json:"type"`
 }
 
 // KeyPairPage stores a single page of all KeyPair results from a List call.
 // Use the ExtractKeyPairs function to convert the results to a slice of
 // KeyPairs.
 type KeyPairPage struct {
-	pagination.SinglePageBase
+	pagination.LinkedPageBase
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results. Links are only returned with microversion 2.35 or
+// later when a limit is applied.
+func (page KeyPairPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"keypairs_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
 }
 
 // IsEmpty determines whether or not a KeyPairPage is empty.
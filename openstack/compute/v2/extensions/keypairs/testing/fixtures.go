@@ -1,6 +1,7 @@
 package testing
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -168,3 +169,153 @@ func HandleDeleteSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// CreateX509Output is a sample response to a Create call that imports an x509
+// certificate for another user.
+const CreateX509Output = `
+{
+	"keypair": {
+		"fingerprint": "ab:cd:ef:12:34:56:78:90:ab:cd:ef:12:34:56:78:90:ab:cd:ef:12",
+		"name": "x509key",
+		"public_key": "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----\n",
+		"type": "x509",
+		"user_id": "fake"
+	}
+}
+`
+
+// X509KeyPair is the parsed result from CreateX509Output.
+var X509KeyPair = keypairs.KeyPair{
+	Name:        "x509key",
+	Fingerprint: "ab:cd:ef:12:34:56:78:90:ab:cd:ef:12:34:56:78:90:ab:cd:ef:12",
+	PublicKey:   "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----\n",
+	Type:        keypairs.TypeX509,
+	UserID:      "fake",
+}
+
+// HandleListPaginatedSuccessfully configures the test server to respond to a
+// paginated List request for the keypairs of user "fake".
+func HandleListPaginatedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-keypairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		expected := map[string]string{"user_id": "fake", "limit": "1"}
+		if marker != "" {
+			expected["marker"] = marker
+		}
+		th.TestFormValues(t, r, expected)
+
+		w.Header().Add("Content-Type", "application/json")
+		switch marker {
+		case "":
+			fmt.Fprintf(w, `
+			{
+				"keypairs": [
+					{
+						"keypair": {
+							"fingerprint": "15:b0:f8:b3:f9:48:63:71:cf:7b:5b:38:6d:44:2d:4a",
+							"name": "firstkey",
+							"public_key": %q
+						}
+					}
+				],
+				"keypairs_links": [
+					{
+						"href": "%s/os-keypairs?limit=1&marker=firstkey&user_id=fake",
+						"rel": "next"
+					}
+				]
+			}`, FirstKeyPair.PublicKey, th.Server.URL)
+		case "firstkey":
+			fmt.Fprintf(w, `
+			{
+				"keypairs": [
+					{
+						"keypair": {
+							"fingerprint": "35:9d:d0:c3:4a:80:d3:d8:86:f1:ca:f7:df:c4:f9:d8",
+							"name": "secondkey",
+							"public_key": %q
+						}
+					}
+				]
+			}`, SecondKeyPair.PublicKey)
+		default:
+			t.Fatalf("Unexpected marker: %s", marker)
+		}
+	})
+}
+
+// HandleCreateX509Successfully configures the test server to respond to a
+// Create request importing an x509 certificate for user "fake".
+func HandleCreateX509Successfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-keypairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+			{
+				"keypair": {
+					"name": "x509key",
+					"public_key": "-----BEGIN CERTIFICATE-----\nMIIC\n-----END CERTIFICATE-----\n",
+					"type": "x509",
+					"user_id": "fake"
+				}
+			}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, CreateX509Output)
+	})
+}
+
+// HandleGetForUserSuccessfully configures the test server to respond to a Get
+// request for "firstkey" of user "fake".
+func HandleGetForUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-keypairs/firstkey", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"user_id": "fake"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleDeleteForUserSuccessfully configures the test server to respond to a
+// Delete request for "deletedkey" of user "fake".
+func HandleDeleteForUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-keypairs/deletedkey", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"user_id": "fake"})
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleGenerateSuccessfully configures the test server to respond to Create
+// requests importing a locally generated public key called "generatedkey".
+func HandleGenerateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-keypairs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		var body struct {
+			KeyPair map[string]interface{} `json:"keypair"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Unable to decode request body: %v", err)
+		}
+		th.AssertEquals(t, "generatedkey", body.KeyPair["name"])
+		if _, ok := body.KeyPair["private_key"]; ok {
+			t.Fatal("The private key must not be sent")
+		}
+
+		body.KeyPair["fingerprint"] = "00:11:22:33:44:55:66:77:88:99:aa:bb:cc:dd:ee:ff"
+		body.KeyPair["user_id"] = "fake"
+
+		w.Header().Add("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(body)
+	})
+}
@@ -0,0 +1,118 @@
+package keypairs
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh"
+)
+
+// KeyAlgorithm is the algorithm used to generate a key pair locally.
+type KeyAlgorithm string
+
+const (
+	// AlgorithmEd25519 generates an Ed25519 key pair.
+	AlgorithmEd25519 KeyAlgorithm = "ed25519"
+
+	// AlgorithmRSA generates an RSA key pair. RSA keys can be used to decrypt
+	// server passwords with servers.GetPasswordResult.ExtractPassword.
+	AlgorithmRSA KeyAlgorithm = "rsa"
+)
+
+// DefaultRSABits is the size of generated RSA keys when GenerateOpts.Bits is
+// not set.
+const DefaultRSABits = 4096
+
+// GenerateOpts specifies the parameters of GenerateAndImport.
+type GenerateOpts struct {
+	// Name is the name of the KeyPair to import.
+	Name string
+
+	// Algorithm is the key algorithm. It defaults to AlgorithmEd25519.
+	Algorithm KeyAlgorithm
+
+	// Bits is the size of RSA keys. It defaults to DefaultRSABits.
+	Bits int
+
+	// UserID [optional] imports the KeyPair for another user.
+	// Requires admin privileges and microversion 2.10 or later.
+	UserID string
+}
+
+// GeneratedKeyPair is a KeyPair generated locally and imported into the
+// Compute service. Its PrivateKey field holds the private key in OpenSSH
+// format.
+type GeneratedKeyPair struct {
+	KeyPair
+
+	// Key is the generated private key, either an ed25519.PrivateKey or an
+	// *rsa.PrivateKey.
+	Key crypto.PrivateKey
+}
+
+// RSAKey returns the generated private key if it is an RSA key, for use with
+// servers.GetPasswordResult.ExtractPassword.
+func (kp GeneratedKeyPair) RSAKey() (*rsa.PrivateKey, bool) {
+	key, ok := kp.Key.(*rsa.PrivateKey)
+	return key, ok
+}
+
+// GenerateAndImport generates a key pair locally, imports its public key into
+// the Compute service and returns the imported KeyPair together with the
+// private key. The private key never leaves the client.
+func GenerateAndImport(client *gophercloud.ServiceClient, opts GenerateOpts) (*GeneratedKeyPair, error) {
+	if opts.Name == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "keypairs.GenerateOpts.Name"
+		return nil, err
+	}
+
+	key, err := generateKey(opts.Algorithm, opts.Bits)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := marshalOpenSSHPrivateKey(key, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	createOpts := CreateOpts{
+		Name:      opts.Name,
+		PublicKey: string(ssh.MarshalAuthorizedKey(signer.PublicKey())),
+		UserID:    opts.UserID,
+	}
+
+	kp, err := Create(client, createOpts).Extract()
+	if err != nil {
+		return nil, err
+	}
+	kp.PrivateKey = string(pem.EncodeToMemory(block))
+
+	return &GeneratedKeyPair{KeyPair: *kp, Key: key}, nil
+}
+
+func generateKey(algorithm KeyAlgorithm, bits int) (crypto.PrivateKey, error) {
+	switch algorithm {
+	case "", AlgorithmEd25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	case AlgorithmRSA:
+		if bits == 0 {
+			bits = DefaultRSABits
+		}
+		return rsa.GenerateKey(rand.Reader, bits)
+	default:
+		return nil, fmt.Errorf("Unsupported key algorithm: %s", algorithm)
+	}
+}
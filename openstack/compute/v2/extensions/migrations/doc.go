@@ -0,0 +1,89 @@
+/*
+Package migrations provides information and interaction with the migrations
+of servers in the OpenStack Compute service. It covers both the
+os-migrations resource, which lists migrations across the cloud, and the
+per-server migrations resource, which can be used to watch and control
+in-progress live migrations.
+
+Example to List Migrations of a Host
+
+	listOpts := migrations.ListOpts{
+		Host:          "compute-01",
+		Status:        "running",
+		MigrationType: migrations.LiveMigration,
+	}
+
+	allPages, err := migrations.List(computeClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := migrations.ExtractMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range allMigrations {
+		fmt.Printf("%+v\n", migration)
+	}
+
+Example to List Migrations Changed Since a Point in Time
+
+	changesSince := time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC)
+	listOpts := migrations.ListOpts{
+		ChangesSince: &changesSince,
+	}
+
+	computeClient.Microversion = "2.59"
+	allPages, err := migrations.List(computeClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the In-Progress Live Migrations of a Server
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+	computeClient.Microversion = "2.23"
+	allPages, err := migrations.ListServerMigrations(computeClient, serverID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allMigrations, err := migrations.ExtractServerMigrations(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, migration := range allMigrations {
+		fmt.Printf("%d: %d bytes of memory remaining\n", migration.ID, migration.MemoryRemainingBytes)
+	}
+
+Example to Get a Server Migration
+
+	serverID := "b16ba811-199d-4ffd-8839-ba96c1185a67"
+	migrationID := 4
+
+	computeClient.Microversion = "2.23"
+	migration, err := migrations.GetServerMigration(computeClient, serverID, migrationID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Force a Live Migration to Complete
+
+	computeClient.Microversion = "2.22"
+	err := migrations.ForceComplete(computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Abort a Live Migration
+
+	computeClient.Microversion = "2.24"
+	err := migrations.Abort(computeClient, serverID, migrationID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package migrations
@@ -0,0 +1,145 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// MigrationType is the type of a migration.
+type MigrationType string
+
+const (
+	// LiveMigration is a live migration of a running server.
+	LiveMigration MigrationType = "live-migration"
+
+	// ColdMigration is a cold migration of a server.
+	ColdMigration MigrationType = "migration"
+
+	// Resize is a migration caused by a resize of a server.
+	Resize MigrationType = "resize"
+
+	// Evacuation is a migration caused by an evacuation of a server.
+	Evacuation MigrationType = "evacuation"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToMigrationListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and paging of the migrations returned by the
+// List call.
+type ListOpts struct {
+	// Host filters the migrations by the source or destination compute host.
+	Host string `q:"host"`
+
+	// Status filters the migrations by their status, e.g. "running" or
+	// "completed".
+	Status string `q:"status"`
+
+	// InstanceUUID filters the migrations by the server they belong to.
+	InstanceUUID string `q:"instance_uuid"`
+
+	// SourceCompute filters the migrations by the source compute host.
+	SourceCompute string `q:"source_compute"`
+
+	// MigrationType filters the migrations by their type.
+	// Requires microversion 2.23 or later.
+	MigrationType MigrationType `q:"migration_type"`
+
+	// ChangesSince filters the migrations to those updated at or after the
+	// given time. Requires microversion 2.59 or later.
+	ChangesSince *time.Time `q:"changes-since"`
+
+	// ChangesBefore filters the migrations to those updated at or before the
+	// given time. Requires microversion 2.66 or later.
+	ChangesBefore *time.Time `q:"changes-before"`
+
+	// UserID filters the migrations by the user that initiated them.
+	// Requires microversion 2.80 or later.
+	UserID string `q:"user_id"`
+
+	// ProjectID filters the migrations by the project of the migrated servers.
+	// Requires microversion 2.80 or later.
+	ProjectID string `q:"project_id"`
+
+	// Marker and Limit control paging. Marker is the UUID of the last migration
+	// seen. Both require microversion 2.59 or later.
+	Marker string `q:"marker"`
+	Limit  int    `q:"limit"`
+}
+
+// ToMigrationListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToMigrationListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	params := q.Query()
+	if opts.ChangesSince != nil {
+		params.Add("changes-since", opts.ChangesSince.Format(time.RFC3339))
+	}
+
+	if opts.ChangesBefore != nil {
+		params.Add("changes-before", opts.ChangesBefore.Format(time.RFC3339))
+	}
+
+	q.RawQuery = params.Encode()
+	return q.String(), nil
+}
+
+// List makes a request against the API to list migrations.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToMigrationListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return MigrationPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListServerMigrations makes a request against the API to list the
+// in-progress live migrations of a server.
+// Requires microversion 2.23 or later.
+func ListServerMigrations(client *gophercloud.ServiceClient, serverID string) pagination.Pager {
+	return pagination.NewPager(client, serverMigrationsURL(client, serverID), func(r pagination.PageResult) pagination.Page {
+		return ServerMigrationPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// GetServerMigration makes a request against the API to get the details of
+// an in-progress live migration of a server.
+// Requires microversion 2.23 or later.
+func GetServerMigration(client *gophercloud.ServiceClient, serverID string, migrationID int) (r GetServerMigrationResult) {
+	_, r.Err = client.Get(serverMigrationURL(client, serverID, migrationID), &r.Body, nil)
+	return
+}
+
+// ForceComplete forces an in-progress live migration of a server to
+// complete by pausing the server on the source host.
+// Requires microversion 2.22 or later.
+func ForceComplete(client *gophercloud.ServiceClient, serverID string, migrationID int) (r ForceCompleteResult) {
+	b := map[string]interface{}{"force_complete": nil}
+	_, r.Err = client.Post(serverMigrationActionURL(client, serverID, migrationID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// Abort aborts an in-progress live migration of a server.
+// Requires microversion 2.24 or later.
+func Abort(client *gophercloud.ServiceClient, serverID string, migrationID int) (r AbortResult) {
+	_, r.Err = client.Delete(serverMigrationURL(client, serverID, migrationID), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,257 @@
+package migrations
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// Migration represents a migration of a server as returned by the
+// os-migrations API.
+type Migration struct {
+	// ID is the ID of the migration.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the migration. Requires microversion 2.59 or later.
+	UUID string `json:"uuid"`
+
+	// InstanceUUID is the UUID of the migrated server.
+	InstanceUUID string `json:"instance_uuid"`
+
+	// SourceCompute is the source compute host of the migration.
+	SourceCompute string `json:"source_compute"`
+
+	// SourceNode is the source compute node of the migration.
+	SourceNode string `json:"source_node"`
+
+	// DestCompute is the destination compute host of the migration.
+	DestCompute string `json:"dest_compute"`
+
+	// DestHost is the IP address of the destination compute host.
+	DestHost string `json:"dest_host"`
+
+	// DestNode is the destination compute node of the migration.
+	DestNode string `json:"dest_node"`
+
+	// OldInstanceTypeID is the ID of the flavor of the server before the
+	// migration.
+	OldInstanceTypeID int `json:"old_instance_type_id"`
+
+	// NewInstanceTypeID is the ID of the flavor of the server after the
+	// migration.
+	NewInstanceTypeID int `json:"new_instance_type_id"`
+
+	// Status is the status of the migration.
+	Status string `json:"status"`
+
+	// MigrationType is the type of the migration.
+	// Requires microversion 2.23 or later.
+	MigrationType MigrationType `json:"migration_type"`
+
+	// UserID is the ID of the user that initiated the migration.
+	// Requires microversion 2.80 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project of the migrated server.
+	// Requires microversion 2.80 or later.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the date and time when the migration was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date and time when the migration was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON to override default
+func (r *Migration) UnmarshalJSON(b []byte) error {
+	type tmp Migration
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = Migration(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// MigrationPage represents a single page of Migrations from a List request.
+type MigrationPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not a page of Migrations contains any
+// results.
+func (page MigrationPage) IsEmpty() (bool, error) {
+	migrations, err := ExtractMigrations(page)
+	return len(migrations) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (page MigrationPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"migrations_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractMigrations interprets the results of a single page from a List call,
+// producing a slice of Migrations.
+func ExtractMigrations(r pagination.Page) ([]Migration, error) {
+	var s struct {
+		Migrations []Migration `json:"migrations"`
+	}
+	err := (r.(MigrationPage)).ExtractInto(&s)
+	return s.Migrations, err
+}
+
+// ServerMigration represents an in-progress live migration of a server.
+type ServerMigration struct {
+	// ID is the ID of the migration.
+	ID int `json:"id"`
+
+	// UUID is the UUID of the migration. Requires microversion 2.59 or later.
+	UUID string `json:"uuid"`
+
+	// ServerUUID is the UUID of the migrated server.
+	ServerUUID string `json:"server_uuid"`
+
+	// SourceCompute is the source compute host of the migration.
+	SourceCompute string `json:"source_compute"`
+
+	// SourceNode is the source compute node of the migration.
+	SourceNode string `json:"source_node"`
+
+	// DestCompute is the destination compute host of the migration.
+	DestCompute string `json:"dest_compute"`
+
+	// DestHost is the IP address of the destination compute host.
+	DestHost string `json:"dest_host"`
+
+	// DestNode is the destination compute node of the migration.
+	DestNode string `json:"dest_node"`
+
+	// Status is the status of the migration.
+	Status string `json:"status"`
+
+	// MemoryTotalBytes is the amount of memory of the server, in bytes.
+	MemoryTotalBytes int64 `json:"memory_total_bytes"`
+
+	// MemoryProcessedBytes is the amount of memory that has been transferred,
+	// in bytes.
+	MemoryProcessedBytes int64 `json:"memory_processed_bytes"`
+
+	// MemoryRemainingBytes is the amount of memory that remains to be
+	// transferred, in bytes.
+	MemoryRemainingBytes int64 `json:"memory_remaining_bytes"`
+
+	// DiskTotalBytes is the amount of disk of the server, in bytes.
+	DiskTotalBytes int64 `json:"disk_total_bytes"`
+
+	// DiskProcessedBytes is the amount of disk that has been transferred,
+	// in bytes.
+	DiskProcessedBytes int64 `json:"disk_processed_bytes"`
+
+	// DiskRemainingBytes is the amount of disk that remains to be
+	// transferred, in bytes.
+	DiskRemainingBytes int64 `json:"disk_remaining_bytes"`
+
+	// UserID is the ID of the user that initiated the migration.
+	// Requires microversion 2.80 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project of the migrated server.
+	// Requires microversion 2.80 or later.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the date and time when the migration was created.
+	CreatedAt time.Time `json:"-"`
+
+	// UpdatedAt is the date and time when the migration was last updated.
+	UpdatedAt time.Time `json:"-"`
+}
+
+// UnmarshalJSON to override default
+func (r *ServerMigration) UnmarshalJSON(b []byte) error {
+	type tmp ServerMigration
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = ServerMigration(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+	r.UpdatedAt = time.Time(s.UpdatedAt)
+
+	return nil
+}
+
+// ServerMigrationPage represents a single page of ServerMigrations from a
+// ListServerMigrations request.
+type ServerMigrationPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a page of ServerMigrations contains any
+// results.
+func (page ServerMigrationPage) IsEmpty() (bool, error) {
+	migrations, err := ExtractServerMigrations(page)
+	return len(migrations) == 0, err
+}
+
+// ExtractServerMigrations interprets the results of a single page from a
+// ListServerMigrations call, producing a slice of ServerMigrations.
+func ExtractServerMigrations(r pagination.Page) ([]ServerMigration, error) {
+	var s struct {
+		Migrations []ServerMigration `json:"migrations"`
+	}
+	err := (r.(ServerMigrationPage)).ExtractInto(&s)
+	return s.Migrations, err
+}
+
+// GetServerMigrationResult is the response from a GetServerMigration
+// operation. Call its Extract method to interpret it as a ServerMigration.
+type GetServerMigrationResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetServerMigrationResult as a ServerMigration.
+func (r GetServerMigrationResult) Extract() (*ServerMigration, error) {
+	var s struct {
+		Migration *ServerMigration `json:"migration"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Migration, err
+}
+
+// ForceCompleteResult is the response from a ForceComplete operation. Call
+// its ExtractErr method to determine if the request succeeded or failed.
+type ForceCompleteResult struct {
+	gophercloud.ErrResult
+}
+
+// AbortResult is the response from an Abort operation. Call its ExtractErr
+// method to determine if the request succeeded or failed.
+type AbortResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,224 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/migrations"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+const serverID = "b16ba811-199d-4ffd-8839-ba96c1185a67"
+
+// ListOutput is a sample response to a List call.
+const ListOutput = `
+{
+    "migrations": [
+        {
+            "created_at": "2016-01-29T13:42:02.000000",
+            "dest_compute": "compute2",
+            "dest_host": "1.2.3.4",
+            "dest_node": "node2",
+            "id": 12,
+            "instance_uuid": "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+            "new_instance_type_id": 1,
+            "old_instance_type_id": 1,
+            "source_compute": "compute1",
+            "source_node": "node1",
+            "status": "running",
+            "migration_type": "live-migration",
+            "updated_at": "2016-01-29T13:42:02.000000",
+            "uuid": "42341d4b-346a-40d0-83c6-5f4f6892b650"
+        },
+        {
+            "created_at": "2016-01-22T13:42:02.000000",
+            "dest_compute": "compute20",
+            "dest_host": "5.6.7.8",
+            "dest_node": "node20",
+            "id": 22,
+            "instance_uuid": "9128d044-7b61-403e-b766-7547076ff6c1",
+            "new_instance_type_id": 6,
+            "old_instance_type_id": 5,
+            "source_compute": "compute10",
+            "source_node": "node10",
+            "status": "migrating",
+            "migration_type": "resize",
+            "updated_at": "2016-01-22T13:42:02.000000",
+            "uuid": "32341d4b-346a-40d0-83c6-5f4f6892b650"
+        }
+    ],
+    "migrations_links": [
+        {
+            "href": "%s/os-migrations?limit=2&marker=32341d4b-346a-40d0-83c6-5f4f6892b650",
+            "rel": "next"
+        }
+    ]
+}
+`
+
+// FirstMigration is the first result in ListOutput.
+var FirstMigration = migrations.Migration{
+	ID:                12,
+	UUID:              "42341d4b-346a-40d0-83c6-5f4f6892b650",
+	InstanceUUID:      "8600d31b-d1a1-4632-b2ff-45c2be1a70ff",
+	SourceCompute:     "compute1",
+	SourceNode:        "node1",
+	DestCompute:       "compute2",
+	DestHost:          "1.2.3.4",
+	DestNode:          "node2",
+	OldInstanceTypeID: 1,
+	NewInstanceTypeID: 1,
+	Status:            "running",
+	MigrationType:     migrations.LiveMigration,
+	CreatedAt:         time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+	UpdatedAt:         time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+}
+
+// SecondMigration is the second result in ListOutput.
+var SecondMigration = migrations.Migration{
+	ID:                22,
+	UUID:              "32341d4b-346a-40d0-83c6-5f4f6892b650",
+	InstanceUUID:      "9128d044-7b61-403e-b766-7547076ff6c1",
+	SourceCompute:     "compute10",
+	SourceNode:        "node10",
+	DestCompute:       "compute20",
+	DestHost:          "5.6.7.8",
+	DestNode:          "node20",
+	OldInstanceTypeID: 5,
+	NewInstanceTypeID: 6,
+	Status:            "migrating",
+	MigrationType:     migrations.Resize,
+	CreatedAt:         time.Date(2016, 1, 22, 13, 42, 2, 0, time.UTC),
+	UpdatedAt:         time.Date(2016, 1, 22, 13, 42, 2, 0, time.UTC),
+}
+
+// ServerMigrationBody is the JSON representation of a single server
+// migration.
+const ServerMigrationBody = `
+        {
+            "created_at": "2016-01-29T13:42:02.000000",
+            "dest_compute": "compute2",
+            "dest_host": "1.2.3.4",
+            "dest_node": "node2",
+            "id": 4,
+            "disk_processed_bytes": 10000,
+            "disk_remaining_bytes": 20000,
+            "disk_total_bytes": 30000,
+            "memory_processed_bytes": 12345,
+            "memory_remaining_bytes": 111111,
+            "memory_total_bytes": 123456,
+            "server_uuid": "b16ba811-199d-4ffd-8839-ba96c1185a67",
+            "source_compute": "compute1",
+            "source_node": "node1",
+            "status": "running",
+            "updated_at": "2016-01-29T13:42:02.000000",
+            "uuid": "12341d4b-346a-40d0-83c6-5f4f6892b650"
+        }
+`
+
+// ListServerMigrationsOutput is a sample response to a ListServerMigrations
+// call.
+var ListServerMigrationsOutput = fmt.Sprintf(`{"migrations": [%s]}`, ServerMigrationBody)
+
+// GetServerMigrationOutput is a sample response to a GetServerMigration
+// call.
+var GetServerMigrationOutput = fmt.Sprintf(`{"migration": %s}`, ServerMigrationBody)
+
+// ExpectedServerMigration is the ServerMigration expected from the fixtures.
+var ExpectedServerMigration = migrations.ServerMigration{
+	ID:                   4,
+	UUID:                 "12341d4b-346a-40d0-83c6-5f4f6892b650",
+	ServerUUID:           serverID,
+	SourceCompute:        "compute1",
+	SourceNode:           "node1",
+	DestCompute:          "compute2",
+	DestHost:             "1.2.3.4",
+	DestNode:             "node2",
+	Status:               "running",
+	MemoryTotalBytes:     123456,
+	MemoryProcessedBytes: 12345,
+	MemoryRemainingBytes: 111111,
+	DiskTotalBytes:       30000,
+	DiskProcessedBytes:   10000,
+	DiskRemainingBytes:   20000,
+	CreatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+	UpdatedAt:            time.Date(2016, 1, 29, 13, 42, 2, 0, time.UTC),
+}
+
+// HandleListSuccessfully configures the test server to respond to a List
+// request.
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		r.ParseForm()
+		marker := r.Form.Get("marker")
+		switch marker {
+		case "":
+			th.TestFormValues(t, r, map[string]string{
+				"host":           "compute1",
+				"status":         "running",
+				"migration_type": "live-migration",
+				"changes-since":  "2016-01-01T00:00:00Z",
+				"limit":          "2",
+			})
+			fmt.Fprintf(w, ListOutput, th.Server.URL)
+		case "32341d4b-346a-40d0-83c6-5f4f6892b650":
+			fmt.Fprint(w, `{"migrations": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+// HandleListServerMigrationsSuccessfully configures the test server to
+// respond to a ListServerMigrations request.
+func HandleListServerMigrationsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ListServerMigrationsOutput)
+	})
+}
+
+// HandleGetServerMigrationSuccessfully configures the test server to respond
+// to a GetServerMigration request.
+func HandleGetServerMigrationSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetServerMigrationOutput)
+	})
+}
+
+// HandleForceCompleteSuccessfully configures the test server to respond to a
+// ForceComplete request.
+func HandleForceCompleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/4/action", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"force_complete": null}`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleAbortSuccessfully configures the test server to respond to an Abort
+// request.
+func HandleAbortSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/"+serverID+"/migrations/4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
@@ -0,0 +1,80 @@
+package testing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/migrations"
+	"github.com/chjlangzi/gophercloud/pagination"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListSuccessfully(t)
+
+	changesSince := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	listOpts := migrations.ListOpts{
+		Host:          "compute1",
+		Status:        "running",
+		MigrationType: migrations.LiveMigration,
+		ChangesSince:  &changesSince,
+		Limit:         2,
+	}
+
+	pages := 0
+	err := migrations.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := migrations.ExtractMigrations(page)
+		th.AssertNoErr(t, err)
+		th.CheckDeepEquals(t, []migrations.Migration{FirstMigration, SecondMigration}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.CheckEquals(t, 1, pages)
+}
+
+func TestListServerMigrations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListServerMigrationsSuccessfully(t)
+
+	allPages, err := migrations.ListServerMigrations(client.ServiceClient(), serverID).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := migrations.ExtractServerMigrations(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []migrations.ServerMigration{ExpectedServerMigration}, actual)
+}
+
+func TestGetServerMigration(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetServerMigrationSuccessfully(t)
+
+	actual, err := migrations.GetServerMigration(client.ServiceClient(), serverID, 4).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedServerMigration, actual)
+}
+
+func TestForceComplete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleForceCompleteSuccessfully(t)
+
+	err := migrations.ForceComplete(client.ServiceClient(), serverID, 4).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAbort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleAbortSuccessfully(t)
+
+	err := migrations.Abort(client.ServiceClient(), serverID, 4).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,23 @@
+package migrations
+
+import (
+	"strconv"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("os-migrations")
+}
+
+func serverMigrationsURL(c *gophercloud.ServiceClient, serverID string) string {
+	return c.ServiceURL("servers", serverID, "migrations")
+}
+
+func serverMigrationURL(c *gophercloud.ServiceClient, serverID string, migrationID int) string {
+	return c.ServiceURL("servers", serverID, "migrations", strconv.Itoa(migrationID))
+}
+
+func serverMigrationActionURL(c *gophercloud.ServiceClient, serverID string, migrationID int) string {
+	return c.ServiceURL("servers", serverID, "migrations", strconv.Itoa(migrationID), "action")
+}
@@ -0,0 +1,29 @@
+/*
+Package quotaclasses enables retrieving and managing Compute quota classes.
+The "default" quota class holds the quotas applied to tenants that have no
+quotas of their own.
+
+Example to Get the Default Quota Class
+
+	quotaClass, err := quotaclasses.Get(computeClient, "default").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaClass)
+
+Example to Update the Default Quota Class
+
+	updateOpts := quotaclasses.UpdateOpts{
+		Instances: gophercloud.IntToPointer(20),
+		Cores:     gophercloud.IntToPointer(40),
+	}
+
+	quotaClass, err := quotaclasses.Update(computeClient, "default", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaClass)
+*/
+package quotaclasses
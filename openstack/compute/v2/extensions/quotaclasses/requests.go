@@ -0,0 +1,88 @@
+package quotaclasses
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// Get returns the quotas of a quota class. Only the "default" quota class
+// is honored by Nova.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = client.Get(getURL(client, id), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder enables extensions to add parameters to the update
+// request.
+type UpdateOptsBuilder interface {
+	ToComputeQuotaClassUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts are the options for updating the quotas of a quota class.
+// All int-values are pointers so they can be nil if they are not needed.
+// You can use gophercloud.IntToPointer() for convenience.
+type UpdateOpts struct {
+	// FixedIPs is number of fixed ips allotted to the quota class.
+	// Removed in microversion 2.50.
+	FixedIPs *int `json:"fixed_ips,omitempty"`
+
+	// FloatingIPs is number of floating ips allotted to the quota class.
+	// Removed in microversion 2.50.
+	FloatingIPs *int `json:"floating_ips,omitempty"`
+
+	// InjectedFileContentBytes is content bytes allowed for each injected file.
+	InjectedFileContentBytes *int `json:"injected_file_content_bytes,omitempty"`
+
+	// InjectedFilePathBytes is allowed bytes for each injected file path.
+	InjectedFilePathBytes *int `json:"injected_file_path_bytes,omitempty"`
+
+	// InjectedFiles is injected files allowed for each project.
+	InjectedFiles *int `json:"injected_files,omitempty"`
+
+	// KeyPairs is number of ssh keypairs.
+	KeyPairs *int `json:"key_pairs,omitempty"`
+
+	// MetadataItems is number of metadata items allowed for each instance.
+	MetadataItems *int `json:"metadata_items,omitempty"`
+
+	// RAM is megabytes allowed for each project.
+	RAM *int `json:"ram,omitempty"`
+
+	// SecurityGroupRules is rules allowed for each security group.
+	// Removed in microversion 2.50.
+	SecurityGroupRules *int `json:"security_group_rules,omitempty"`
+
+	// SecurityGroups security groups allowed for each project.
+	// Removed in microversion 2.50.
+	SecurityGroups *int `json:"security_groups,omitempty"`
+
+	// Cores is number of instance cores allowed for each project.
+	Cores *int `json:"cores,omitempty"`
+
+	// Instances is number of instances allowed for each project.
+	Instances *int `json:"instances,omitempty"`
+
+	// ServerGroups is the number of ServerGroups allowed for each project.
+	ServerGroups *int `json:"server_groups,omitempty"`
+
+	// ServerGroupMembers is the number of members for each ServerGroup.
+	ServerGroupMembers *int `json:"server_group_members,omitempty"`
+}
+
+// ToComputeQuotaClassUpdateMap builds the update options into a serializable
+// format.
+func (opts UpdateOpts) ToComputeQuotaClassUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "quota_class_set")
+}
+
+// Update updates the quotas of a quota class and returns the new
+// QuotaClassSet.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	reqBody, err := opts.ToComputeQuotaClassUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(updateURL(client, id), reqBody, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{200}})
+	return
+}
@@ -0,0 +1,81 @@
+package quotaclasses
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// QuotaClassSet is the set of default quotas applied to tenants that have no
+// quotas of their own.
+type QuotaClassSet struct {
+	// ID is the name of the quota class. It is only returned by Get.
+	ID string `json:"id"`
+
+	// FixedIPs is number of fixed ips allotted to the quota class.
+	FixedIPs int `json:"fixed_ips"`
+
+	// FloatingIPs is number of floating ips allotted to the quota class.
+	FloatingIPs int `json:"floating_ips"`
+
+	// InjectedFileContentBytes is the allowed bytes for each injected file.
+	InjectedFileContentBytes int `json:"injected_file_content_bytes"`
+
+	// InjectedFilePathBytes is allowed bytes for each injected file path.
+	InjectedFilePathBytes int `json:"injected_file_path_bytes"`
+
+	// InjectedFiles is the number of injected files allowed for each project.
+	InjectedFiles int `json:"injected_files"`
+
+	// KeyPairs is number of ssh keypairs.
+	KeyPairs int `json:"key_pairs"`
+
+	// MetadataItems is number of metadata items allowed for each instance.
+	MetadataItems int `json:"metadata_items"`
+
+	// RAM is megabytes allowed for each project.
+	RAM int `json:"ram"`
+
+	// SecurityGroupRules is number of security group rules allowed for each
+	// security group.
+	SecurityGroupRules int `json:"security_group_rules"`
+
+	// SecurityGroups is the number of security groups allowed for each project.
+	SecurityGroups int `json:"security_groups"`
+
+	// Cores is number of instance cores allowed for each project.
+	Cores int `json:"cores"`
+
+	// Instances is number of instances allowed for each project.
+	Instances int `json:"instances"`
+
+	// ServerGroups is the number of ServerGroups allowed for each project.
+	ServerGroups int `json:"server_groups"`
+
+	// ServerGroupMembers is the number of members for each ServerGroup.
+	ServerGroupMembers int `json:"server_group_members"`
+}
+
+type quotaClassResult struct {
+	gophercloud.Result
+}
+
+// Extract is a method that attempts to interpret any QuotaClassSet resource
+// response as a QuotaClassSet struct.
+func (r quotaClassResult) Extract() (*QuotaClassSet, error) {
+	var s struct {
+		QuotaClassSet *QuotaClassSet `json:"quota_class_set"`
+	}
+	err := r.ExtractInto(&s)
+	return s.QuotaClassSet, err
+}
+
+// GetResult is the response from a Get operation. Call its Extract method to
+// interpret it as a QuotaClassSet.
+type GetResult struct {
+	quotaClassResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a QuotaClassSet.
+type UpdateResult struct {
+	quotaClassResult
+}
@@ -0,0 +1,2 @@
+// quotaclasses unit tests
+package testing
@@ -0,0 +1,128 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/quotaclasses"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+// GetOutput is a sample response to a Get call.
+const GetOutput = `
+{
+    "quota_class_set": {
+        "cores": 20,
+        "fixed_ips": -1,
+        "floating_ips": 10,
+        "id": "default",
+        "injected_file_content_bytes": 10240,
+        "injected_file_path_bytes": 255,
+        "injected_files": 5,
+        "instances": 10,
+        "key_pairs": 100,
+        "metadata_items": 128,
+        "ram": 51200,
+        "security_group_rules": 20,
+        "security_groups": 10,
+        "server_groups": 10,
+        "server_group_members": 10
+    }
+}
+`
+
+// UpdateRequest is the expected body of an Update call.
+const UpdateRequest = `
+{
+    "quota_class_set": {
+        "cores": 40,
+        "instances": 20
+    }
+}
+`
+
+// UpdateOutput is a sample response to an Update call.
+const UpdateOutput = `
+{
+    "quota_class_set": {
+        "cores": 40,
+        "fixed_ips": -1,
+        "floating_ips": 10,
+        "injected_file_content_bytes": 10240,
+        "injected_file_path_bytes": 255,
+        "injected_files": 5,
+        "instances": 20,
+        "key_pairs": 100,
+        "metadata_items": 128,
+        "ram": 51200,
+        "security_group_rules": 20,
+        "security_groups": 10,
+        "server_groups": 10,
+        "server_group_members": 10
+    }
+}
+`
+
+// DefaultQuotaClassSet is the result of GetOutput.
+var DefaultQuotaClassSet = quotaclasses.QuotaClassSet{
+	ID:                       "default",
+	Cores:                    20,
+	FixedIPs:                 -1,
+	FloatingIPs:              10,
+	InjectedFileContentBytes: 10240,
+	InjectedFilePathBytes:    255,
+	InjectedFiles:            5,
+	Instances:                10,
+	KeyPairs:                 100,
+	MetadataItems:            128,
+	RAM:                      51200,
+	SecurityGroupRules:       20,
+	SecurityGroups:           10,
+	ServerGroups:             10,
+	ServerGroupMembers:       10,
+}
+
+// UpdatedQuotaClassSet is the result of UpdateOutput.
+var UpdatedQuotaClassSet = quotaclasses.QuotaClassSet{
+	Cores:                    40,
+	FixedIPs:                 -1,
+	FloatingIPs:              10,
+	InjectedFileContentBytes: 10240,
+	InjectedFilePathBytes:    255,
+	InjectedFiles:            5,
+	Instances:                20,
+	KeyPairs:                 100,
+	MetadataItems:            128,
+	RAM:                      51200,
+	SecurityGroupRules:       20,
+	SecurityGroups:           10,
+	ServerGroups:             10,
+	ServerGroupMembers:       10,
+}
+
+// HandleGetSuccessfully configures the test server to respond to a Get
+// request for the default quota class.
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-class-sets/default", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request for the default quota class.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-class-sets/default", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
@@ -0,0 +1,35 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/quotaclasses"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetSuccessfully(t)
+
+	actual, err := quotaclasses.Get(client.ServiceClient(), "default").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &DefaultQuotaClassSet, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	opts := quotaclasses.UpdateOpts{
+		Cores:     gophercloud.IntToPointer(40),
+		Instances: gophercloud.IntToPointer(20),
+	}
+
+	actual, err := quotaclasses.Update(client.ServiceClient(), "default", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &UpdatedQuotaClassSet, actual)
+}
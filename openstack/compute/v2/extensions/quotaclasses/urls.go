@@ -0,0 +1,13 @@
+package quotaclasses
+
+import "github.com/chjlangzi/gophercloud"
+
+const resourcePath = "os-quota-class-sets"
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return getURL(c, id)
+}
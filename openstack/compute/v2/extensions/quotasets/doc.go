@@ -32,5 +32,31 @@ Example to Update a Quota Set
 	}
 
 	fmt.Printf("%+v\n", quotaset)
+
+Example to Update the Quota Set of a User
+
+	updateOpts := quotasets.UpdateOpts{
+		Instances: gophercloud.IntToPointer(5),
+	}
+
+	quotaset, err := quotasets.UpdateForUser(computeClient, "tenant-id", "user-id", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", quotaset)
+
+Example to Check the Headroom Before Launching Servers
+
+	headroom, err := quotasets.GetHeadroom(computeClient, "tenant-id")
+	if err != nil {
+		panic(err)
+	}
+
+	// The number of 4 vCPU, 8 GB servers that can still be launched.
+	n := quotasets.MaxServers(headroom, 4, 8192)
+	if n >= 0 && n < 50 {
+		fmt.Printf("only %d servers fit in the quota\n", n)
+	}
 */
 package quotasets
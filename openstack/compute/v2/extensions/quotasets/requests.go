@@ -16,6 +16,20 @@ func GetDetail(client *gophercloud.ServiceClient, tenantID string) (r GetDetailR
 	return
 }
 
+// GetForUser returns the quotas of a single user of a tenant. Quotas that
+// have not been overridden for the user fall back to the tenant quotas.
+func GetForUser(client *gophercloud.ServiceClient, tenantID, userID string) (r GetResult) {
+	_, r.Err = client.Get(withUserID(getURL(client, tenantID), userID), &r.Body, nil)
+	return
+}
+
+// GetDetailForUser returns the detailed quotas and usage of a single user of
+// a tenant.
+func GetDetailForUser(client *gophercloud.ServiceClient, tenantID, userID string) (r GetDetailResult) {
+	_, r.Err = client.Get(withUserID(getDetailURL(client, tenantID), userID), &r.Body, nil)
+	return
+}
+
 // Updates the quotas for the given tenantID and returns the new QuotaSet.
 func Update(client *gophercloud.ServiceClient, tenantID string, opts UpdateOptsBuilder) (r UpdateResult) {
 	reqBody, err := opts.ToComputeQuotaUpdateMap()
@@ -28,12 +42,32 @@ func Update(client *gophercloud.ServiceClient, tenantID string, opts UpdateOptsB
 	return
 }
 
+// UpdateForUser overrides the quotas of a single user of a tenant and returns
+// the new QuotaSet. A user quota cannot exceed the tenant quota.
+func UpdateForUser(client *gophercloud.ServiceClient, tenantID, userID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	reqBody, err := opts.ToComputeQuotaUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(withUserID(updateURL(client, tenantID), userID), reqBody, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{200}})
+	return
+}
+
 // Resets the quotas for the given tenant to their default values.
 func Delete(client *gophercloud.ServiceClient, tenantID string) (r DeleteResult) {
 	_, r.Err = client.Delete(deleteURL(client, tenantID), nil)
 	return
 }
 
+// DeleteForUser removes the quota overrides of a single user of a tenant, so
+// the tenant quotas apply to the user again.
+func DeleteForUser(client *gophercloud.ServiceClient, tenantID, userID string) (r DeleteResult) {
+	_, r.Err = client.Delete(withUserID(deleteURL(client, tenantID), userID), nil)
+	return
+}
+
 // Options for Updating the quotas of a Tenant.
 // All int-values are pointers so they can be nil if they are not needed.
 // You can use gopercloud.IntToPointer() for convenience
@@ -213,3 +213,112 @@ func HandleDeleteSuccessfully(t *testing.T) {
 		w.WriteHeader(202)
 	})
 }
+
+// FirstUserID is the user the user-scoped quota fixtures apply to.
+const FirstUserID = "c2b7a4b5a26b4c5b8f2e36c3bd2b3c43"
+
+// HandleGetForUserSuccessfully configures the test server to respond to a
+// Get request for a user of the sample tenant.
+func HandleGetForUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+FirstTenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"user_id": FirstUserID})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutput)
+	})
+}
+
+// HandleGetDetailForUserSuccessfully configures the test server to respond
+// to a Get Details request for a user of the sample tenant.
+func HandleGetDetailForUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+FirstTenantID+"/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"user_id": FirstUserID})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetDetailsOutput)
+	})
+}
+
+// HandlePutForUserSuccessfully configures the test server to respond to a
+// Put request for a user of the sample tenant.
+func HandlePutForUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+FirstTenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"user_id": FirstUserID})
+		th.TestJSONRequest(t, r, PartialUpdateBody)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, UpdateOutput)
+	})
+}
+
+// HandleDeleteForUserSuccessfully configures the test server to respond to
+// a Delete request for a user of the sample tenant.
+func HandleDeleteForUserSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-quota-sets/"+FirstTenantID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"user_id": FirstUserID})
+
+		w.WriteHeader(202)
+	})
+}
+
+// LimitsOutput is a sample response to a limits Get call for the sample
+// tenant.
+const LimitsOutput = `
+{
+    "limits": {
+        "rate": [],
+        "absolute": {
+            "maxServerMeta": 128,
+            "maxPersonality": 5,
+            "totalServerGroupsUsed": 1,
+            "maxImageMeta": 128,
+            "maxPersonalitySize": 10240,
+            "maxTotalKeypairs": 10,
+            "maxSecurityGroupRules": 20,
+            "maxServerGroups": 2,
+            "totalCoresUsed": 16,
+            "totalRAMUsed": 32768,
+            "totalInstancesUsed": 4,
+            "maxSecurityGroups": 10,
+            "totalFloatingIpsUsed": 0,
+            "maxTotalCores": 200,
+            "maxServerGroupMembers": 3,
+            "maxTotalFloatingIps": 10,
+            "totalSecurityGroupsUsed": 1,
+            "maxTotalInstances": 20,
+            "maxTotalRAMSize": 200000
+        }
+    }
+}
+`
+
+// ExpectedHeadroom is the headroom computed from GetDetailsOutput and
+// LimitsOutput.
+var ExpectedHeadroom = map[string]quotasets.Headroom{
+	quotasets.ResourceInstances:    {Limit: 20, Used: 4, Reserved: 0, Available: 16},
+	quotasets.ResourceCores:        {Limit: 200, Used: 16, Reserved: 0, Available: 184},
+	quotasets.ResourceRAM:          {Limit: 200000, Used: 32768, Reserved: 0, Available: 167232},
+	quotasets.ResourceKeyPairs:     {Limit: 10, Used: 0, Reserved: 0, Available: 10},
+	quotasets.ResourceServerGroups: {Limit: 2, Used: 1, Reserved: 0, Available: 1},
+}
+
+// HandleLimitsSuccessfully configures the test server to respond to a limits
+// Get request for the sample tenant.
+func HandleLimitsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/limits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"tenant_id": FirstTenantID})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, LimitsOutput)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/limits"
 	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/quotasets"
 	th "github.com/chjlangzi/gophercloud/testhelper"
 	"github.com/chjlangzi/gophercloud/testhelper/client"
@@ -71,3 +72,80 @@ func TestErrorInToComputeQuotaUpdateMap(t *testing.T) {
 		t.Fatal("Error handling failed")
 	}
 }
+
+func TestGetForUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetForUserSuccessfully(t)
+	actual, err := quotasets.GetForUser(client.ServiceClient(), FirstTenantID, FirstUserID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstQuotaSet, actual)
+}
+
+func TestGetDetailForUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDetailForUserSuccessfully(t)
+	actual, err := quotasets.GetDetailForUser(client.ServiceClient(), FirstTenantID, FirstUserID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, FirstQuotaDetailsSet, actual)
+}
+
+func TestUpdateForUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandlePutForUserSuccessfully(t)
+	opts := quotasets.UpdateOpts{Cores: gophercloud.IntToPointer(200), Force: true}
+	actual, err := quotasets.UpdateForUser(client.ServiceClient(), FirstTenantID, FirstUserID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &FirstQuotaSet, actual)
+}
+
+func TestDeleteForUser(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleDeleteForUserSuccessfully(t)
+	_, err := quotasets.DeleteForUser(client.ServiceClient(), FirstTenantID, FirstUserID).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetHeadroom(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetDetailSuccessfully(t)
+	HandleLimitsSuccessfully(t)
+	actual, err := quotasets.GetHeadroom(client.ServiceClient(), FirstTenantID)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedHeadroom, actual)
+
+	// Instances are the tightest constraint: 16 more servers.
+	th.AssertEquals(t, 16, quotasets.MaxServers(actual, 4, 8192))
+
+	// RAM is the tightest constraint: 167232 / 32768 = 5 more servers.
+	th.AssertEquals(t, 5, quotasets.MaxServers(actual, 2, 32768))
+}
+
+func TestCalculateHeadroom(t *testing.T) {
+	detail := quotasets.QuotaDetailSet{
+		Instances: quotasets.QuotaDetail{InUse: 8, Reserved: 2, Limit: 10},
+		Cores:     quotasets.QuotaDetail{InUse: 4, Reserved: 0, Limit: -1},
+		RAM:       quotasets.QuotaDetail{InUse: 1024, Reserved: 0, Limit: -1},
+	}
+	absolute := limits.Absolute{
+		MaxTotalInstances:  10,
+		TotalInstancesUsed: 8,
+		MaxTotalCores:      -1,
+		TotalCoresUsed:     4,
+		MaxTotalRAMSize:    -1,
+		TotalRAMUsed:       1024,
+	}
+
+	actual := quotasets.CalculateHeadroom(detail, absolute)
+	th.AssertEquals(t, 0, actual[quotasets.ResourceInstances].Available)
+	th.AssertEquals(t, true, actual[quotasets.ResourceCores].Unlimited())
+	th.AssertEquals(t, -1, actual[quotasets.ResourceCores].Available)
+	th.AssertEquals(t, 0, quotasets.MaxServers(actual, 2, 2048))
+
+	delete(actual, quotasets.ResourceInstances)
+	th.AssertEquals(t, -1, quotasets.MaxServers(actual, 2, 2048))
+}
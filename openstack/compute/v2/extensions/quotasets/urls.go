@@ -1,6 +1,10 @@
 package quotasets
 
-import "github.com/chjlangzi/gophercloud"
+import (
+	"net/url"
+
+	"github.com/chjlangzi/gophercloud"
+)
 
 const resourcePath = "os-quota-sets"
 
@@ -23,3 +27,8 @@ func updateURL(c *gophercloud.ServiceClient, tenantID string) string {
 func deleteURL(c *gophercloud.ServiceClient, tenantID string) string {
 	return getURL(c, tenantID)
 }
+
+// withUserID scopes a quota set URL to a single user of the tenant.
+func withUserID(baseURL, userID string) string {
+	return baseURL + "?" + url.Values{"user_id": []string{userID}}.Encode()
+}
@@ -0,0 +1,126 @@
+package quotasets
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/limits"
+)
+
+// Keys of the map returned by CalculateHeadroom. They match the resource names
+// of the os-quota-sets API.
+const (
+	ResourceInstances    = "instances"
+	ResourceCores        = "cores"
+	ResourceRAM          = "ram"
+	ResourceKeyPairs     = "key_pairs"
+	ResourceServerGroups = "server_groups"
+)
+
+// Headroom is the remaining capacity of a single compute quota resource.
+type Headroom struct {
+	// Limit is the stricter of the quota limit and the absolute limit
+	// reported by os-limits. A negative limit means the resource is
+	// unlimited.
+	Limit int
+
+	// Used is the larger of the quota's in_use count and the matching
+	// total*Used value reported by os-limits.
+	Used int
+
+	// Reserved is the quota's reserved count. Nova only reports non-zero
+	// values here while a server build holds a reservation.
+	Reserved int
+
+	// Available is the amount of the resource that can still be consumed,
+	// or -1 if the resource is unlimited.
+	Available int
+}
+
+// Unlimited reports whether the resource has no limit.
+func (h Headroom) Unlimited() bool {
+	return h.Limit < 0
+}
+
+// CalculateHeadroom compares the detailed quotas of a tenant with its
+// absolute limits and returns the headroom of each resource. Where both
+// sources report a limit the stricter one is used, and where both report
+// usage the larger one is used.
+//
+// Floating IPs and security groups are left out since they are managed by
+// the Networking service.
+func CalculateHeadroom(detail QuotaDetailSet, absolute limits.Absolute) map[string]Headroom {
+	return map[string]Headroom{
+		ResourceInstances:    headroom(detail.Instances, absolute.MaxTotalInstances, absolute.TotalInstancesUsed),
+		ResourceCores:        headroom(detail.Cores, absolute.MaxTotalCores, absolute.TotalCoresUsed),
+		ResourceRAM:          headroom(detail.RAM, absolute.MaxTotalRAMSize, absolute.TotalRAMUsed),
+		ResourceKeyPairs:     headroom(detail.KeyPairs, absolute.MaxTotalKeypairs, 0),
+		ResourceServerGroups: headroom(detail.ServerGroups, absolute.MaxServerGroups, absolute.TotalServerGroupsUsed),
+	}
+}
+
+func headroom(detail QuotaDetail, limit, used int) Headroom {
+	h := Headroom{
+		Limit:    detail.Limit,
+		Used:     detail.InUse,
+		Reserved: detail.Reserved,
+	}
+
+	if limit >= 0 && (h.Limit < 0 || limit < h.Limit) {
+		h.Limit = limit
+	}
+
+	if used > h.Used {
+		h.Used = used
+	}
+
+	if h.Unlimited() {
+		h.Available = -1
+		return h
+	}
+
+	h.Available = h.Limit - h.Used - h.Reserved
+	if h.Available < 0 {
+		h.Available = 0
+	}
+
+	return h
+}
+
+// GetHeadroom retrieves the detailed quotas and the absolute limits of a
+// tenant and returns the headroom of each resource.
+func GetHeadroom(client *gophercloud.ServiceClient, tenantID string) (map[string]Headroom, error) {
+	detail, err := GetDetail(client, tenantID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := limits.Get(client, limits.GetOpts{TenantID: tenantID}).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return CalculateHeadroom(detail, l.Absolute), nil
+}
+
+// MaxServers returns how many more servers with the given number of vCPUs
+// and megabytes of RAM fit in the headroom, or -1 if the number of servers
+// is unlimited.
+func MaxServers(headroom map[string]Headroom, vcpus, ram int) int {
+	max := -1
+
+	fit := func(resource string, size int) {
+		h, ok := headroom[resource]
+		if !ok || h.Unlimited() || size <= 0 {
+			return
+		}
+		n := h.Available / size
+		if max < 0 || n < max {
+			max = n
+		}
+	}
+
+	fit(ResourceInstances, 1)
+	fit(ResourceCores, vcpus)
+	fit(ResourceRAM, ram)
+
+	return max
+}
@@ -3,7 +3,7 @@ Package servergroups provides the ability to manage server groups.
 
 Example to List Server Groups
 
-	allpages, err := servergroups.List(computeClient).AllPages()
+	allpages, err := servergroups.List(computeClient, nil).AllPages()
 	if err != nil {
 		panic(err)
 	}
@@ -29,6 +29,33 @@ Example to Create a Server Group
 		panic(err)
 	}
 
+Example to Create a Server Group with a Policy and Rules (microversion 2.64)
+
+	computeClient.Microversion = "2.64"
+
+	createOpts := servergroups.CreateOpts{
+		Name:   "my_sg",
+		Policy: servergroups.PolicyAntiAffinity,
+		Rules: &servergroups.Rules{
+			MaxServerPerHost: 2,
+		},
+	}
+
+	sg, err := servergroups.Create(computeClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Check the Placement of Server Group Members
+
+	sgID := "7a6f29ad-e34d-4368-951a-58a08f11cfb7"
+	err := servergroups.CheckPlacement(computeClient, sgID)
+	if violation, ok := err.(servergroups.ErrPolicyViolated); ok {
+		fmt.Printf("members share hosts: %v\n", violation.Distribution)
+	} else if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Server Group
 
 	sgID := "7a6f29ad-e34d-4368-951a-58a08f11cfb7"
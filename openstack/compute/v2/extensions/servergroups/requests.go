@@ -5,14 +5,63 @@ import (
 	"github.com/chjlangzi/gophercloud/pagination"
 )
 
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToServerGroupListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of server groups.
+type ListOpts struct {
+	// AllProjects lists the server groups of all projects. Admin only.
+	AllProjects bool `q:"all_projects"`
+
+	// Limit is the maximum number of server groups to return.
+	Limit int `q:"limit"`
+
+	// Offset is the number of server groups to skip.
+	Offset int `q:"offset"`
+}
+
+// ToServerGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToServerGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
 // List returns a Pager that allows you to iterate over a collection of
 // ServerGroups.
-func List(client *gophercloud.ServiceClient) pagination.Pager {
-	return pagination.NewPager(client, listURL(client), func(r pagination.PageResult) pagination.Page {
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+	if opts != nil {
+		query, err := opts.ToServerGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
 		return ServerGroupPage{pagination.SinglePageBase(r)}
 	})
 }
 
+// Server group policies.
+const (
+	// PolicyAffinity places all members on the same host.
+	PolicyAffinity = "affinity"
+
+	// PolicyAntiAffinity places each member on a different host, or at most
+	// Rules.MaxServerPerHost members on each host.
+	PolicyAntiAffinity = "anti-affinity"
+
+	// PolicySoftAffinity places members on the same host when possible.
+	PolicySoftAffinity = "soft-affinity"
+
+	// PolicySoftAntiAffinity places members on different hosts when possible.
+	PolicySoftAntiAffinity = "soft-anti-affinity"
+)
+
 // CreateOptsBuilder allows extensions to add additional parameters to the
 // Create request.
 type CreateOptsBuilder interface {
@@ -24,12 +73,28 @@ type CreateOpts struct {
 	// Name is the name of the server group
 	Name string `json:"name" required:"true"`
 
-	// Policies are the server group policies
-	Policies []string `json:"policies" required:"true"`
+	// Policies are the server group policies. Replaced by Policy in
+	// microversion 2.64.
+	Policies []string `json:"policies,omitempty"`
+
+	// Policy is the server group policy.
+	// Requires microversion 2.64 or later.
+	Policy string `json:"policy,omitempty"`
+
+	// Rules are the rules of the server group policy. Only the
+	// anti-affinity policy supports rules.
+	// Requires microversion 2.64 or later.
+	Rules *Rules `json:"rules,omitempty"`
 }
 
 // ToServerGroupCreateMap constructs a request body from CreateOpts.
 func (opts CreateOpts) ToServerGroupCreateMap() (map[string]interface{}, error) {
+	if (len(opts.Policies) == 0) == (opts.Policy == "") {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "servergroups.CreateOpts.Policies/servergroups.CreateOpts.Policy"
+		return nil, err
+	}
+
 	return gophercloud.BuildRequestBody(opts, "server_group")
 }
 
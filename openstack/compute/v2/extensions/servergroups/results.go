@@ -24,14 +24,48 @@ type ServerGroup struct {
 	// compute nodes.
 	Policies []string `json:"policies"`
 
+	// Policy is the group policy. It replaces Policies in microversion 2.64.
+	Policy string `json:"policy"`
+
+	// Rules are the rules of the group policy.
+	// Requires microversion 2.64 or later.
+	Rules *Rules `json:"rules"`
+
 	// Members are the members of the server group.
 	Members []string `json:"members"`
 
+	// UserID is the ID of the user who owns the server group.
+	// Requires microversion 2.13 or later.
+	UserID string `json:"user_id"`
+
+	// ProjectID is the ID of the project that owns the server group.
+	// Requires microversion 2.13 or later.
+	ProjectID string `json:"project_id"`
+
 	// Metadata includes a list of all user-specified key-value pairs attached
 	// to the Server Group.
 	Metadata map[string]interface{}
 }
 
+// Rules are the rules of a server group policy.
+type Rules struct {
+	// MaxServerPerHost is the maximum number of members of an anti-affinity
+	// server group placed on the same host.
+	MaxServerPerHost int `json:"max_server_per_host,omitempty"`
+}
+
+// EffectivePolicy returns the policy of the server group regardless of the
+// microversion it was retrieved with.
+func (sg ServerGroup) EffectivePolicy() string {
+	if sg.Policy != "" {
+		return sg.Policy
+	}
+	if len(sg.Policies) > 0 {
+		return sg.Policies[0]
+	}
+	return ""
+}
+
 // ServerGroupPage stores a single page of all ServerGroups results from a
 // List call.
 type ServerGroupPage struct {
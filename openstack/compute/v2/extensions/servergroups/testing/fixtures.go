@@ -158,3 +158,155 @@ func HandleDeleteSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// CreateOutputMicroversion is a sample response to a Post call with
+// microversion 2.64.
+const CreateOutputMicroversion = `
+{
+    "server_group": {
+        "id": "616fb98f-46ca-475e-917e-2563e5a8cd19",
+        "name": "test",
+        "policy": "anti-affinity",
+        "rules": {
+            "max_server_per_host": 3
+        },
+        "members": [],
+        "project_id": "6f70656e737461636b20342065766572",
+        "user_id": "fake"
+    }
+}
+`
+
+// GetOutputWithMembers is a sample response to a Get call for a server
+// group with members.
+const GetOutputWithMembers = `
+{
+    "server_group": {
+        "id": "616fb98f-46ca-475e-917e-2563e5a8cd19",
+        "name": "test",
+        "policy": "soft-anti-affinity",
+        "rules": {},
+        "members": [
+            "21f5b6ca-8e0e-4e6b-9e9e-5f5f4e2b7d57",
+            "5cf8da2c-57a7-4bb6-8a8b-6a2f1e2b9a0a",
+            "be0cbc16-6ee5-4bb3-b4ed-1e0ca1a8ad0b"
+        ],
+        "project_id": "6f70656e737461636b20342065766572",
+        "user_id": "fake"
+    }
+}
+`
+
+// CreatedServerGroupMicroversion is the parsed result from
+// CreateOutputMicroversion.
+var CreatedServerGroupMicroversion = servergroups.ServerGroup{
+	ID:     "616fb98f-46ca-475e-917e-2563e5a8cd19",
+	Name:   "test",
+	Policy: "anti-affinity",
+	Rules: &servergroups.Rules{
+		MaxServerPerHost: 3,
+	},
+	Members:   []string{},
+	ProjectID: "6f70656e737461636b20342065766572",
+	UserID:    "fake",
+}
+
+// MemberHosts maps the members of GetOutputWithMembers to their hosts.
+var MemberHosts = map[string]string{
+	"21f5b6ca-8e0e-4e6b-9e9e-5f5f4e2b7d57": "compute-1",
+	"5cf8da2c-57a7-4bb6-8a8b-6a2f1e2b9a0a": "compute-2",
+	"be0cbc16-6ee5-4bb3-b4ed-1e0ca1a8ad0b": "compute-1",
+}
+
+// HandleCreateMicroversionSuccessfully configures the test server to respond
+// to a Create request with a policy and rules.
+func HandleCreateMicroversionSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-server-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+    "server_group": {
+        "name": "test",
+        "policy": "anti-affinity",
+        "rules": {
+            "max_server_per_host": 3
+        }
+    }
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, CreateOutputMicroversion)
+	})
+}
+
+// HandleListAllProjectsSuccessfully configures the test server to respond to
+// a List request for the server groups of all projects.
+func HandleListAllProjectsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-server-groups", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"all_projects": "true"})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+{
+    "server_groups": [
+        {
+            "id": "616fb98f-46ca-475e-917e-2563e5a8cd19",
+            "name": "test",
+            "policy": "anti-affinity",
+            "rules": {
+                "max_server_per_host": 3
+            },
+            "members": [],
+            "project_id": "6f70656e737461636b20342065766572",
+            "user_id": "fake"
+        },
+        {
+            "id": "4d8c3732-a248-40ed-bebc-539a6ffd25c0",
+            "name": "test2",
+            "policy": "affinity",
+            "rules": {},
+            "members": [],
+            "project_id": "ee0cbc16c6ee5bb3b4ed1e0ca1a8ad0b",
+            "user_id": "fake"
+        }
+    ]
+}
+`)
+	})
+}
+
+// HandleGetWithMembersSuccessfully configures the test server to respond to
+// a Get request for a server group with members, and to Get requests for
+// each member and to the quota request of the project owning the group.
+func HandleGetWithMembersSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-server-groups/616fb98f-46ca-475e-917e-2563e5a8cd19", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetOutputWithMembers)
+	})
+
+	for id, host := range MemberHosts {
+		id, host := id, host
+		th.Mux.HandleFunc("/servers/"+id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "GET")
+			th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+			w.Header().Add("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"server": {"id": "%s", "status": "ACTIVE", "OS-EXT-SRV-ATTR:host": "%s"}}`, id, host)
+		})
+	}
+
+	th.Mux.HandleFunc("/os-quota-sets/6f70656e737461636b20342065766572", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `{"quota_set": {"id": "6f70656e737461636b20342065766572", "server_group_members": 10, "server_groups": 10}}`)
+	})
+}
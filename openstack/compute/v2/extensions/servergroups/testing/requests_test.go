@@ -15,7 +15,7 @@ func TestList(t *testing.T) {
 	HandleListSuccessfully(t)
 
 	count := 0
-	err := servergroups.List(client.ServiceClient()).EachPage(func(page pagination.Page) (bool, error) {
+	err := servergroups.List(client.ServiceClient(), nil).EachPage(func(page pagination.Page) (bool, error) {
 		count++
 		actual, err := servergroups.ExtractServerGroups(page)
 		th.AssertNoErr(t, err)
@@ -58,3 +58,92 @@ func TestDelete(t *testing.T) {
 	err := servergroups.Delete(client.ServiceClient(), "616fb98f-46ca-475e-917e-2563e5a8cd19").ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestCreateWithPolicyAndRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleCreateMicroversionSuccessfully(t)
+
+	actual, err := servergroups.Create(client.ServiceClient(), servergroups.CreateOpts{
+		Name:   "test",
+		Policy: servergroups.PolicyAntiAffinity,
+		Rules: &servergroups.Rules{
+			MaxServerPerHost: 3,
+		},
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &CreatedServerGroupMicroversion, actual)
+	th.AssertEquals(t, servergroups.PolicyAntiAffinity, actual.EffectivePolicy())
+}
+
+func TestCreateRequiresOnePolicy(t *testing.T) {
+	res := servergroups.Create(client.ServiceClient(), servergroups.CreateOpts{
+		Name: "test",
+	})
+	if res.Err == nil {
+		t.Fatal("Expected error, got none")
+	}
+
+	res = servergroups.Create(client.ServiceClient(), servergroups.CreateOpts{
+		Name:     "test",
+		Policies: []string{"affinity"},
+		Policy:   servergroups.PolicyAffinity,
+	})
+	if res.Err == nil {
+		t.Fatal("Expected error, got none")
+	}
+}
+
+func TestListByProject(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleListAllProjectsSuccessfully(t)
+
+	actual, err := servergroups.ListByProject(client.ServiceClient(), "6f70656e737461636b20342065766572")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, []servergroups.ServerGroup{CreatedServerGroupMicroversion}, actual)
+}
+
+func TestGetMemberCount(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetWithMembersSuccessfully(t)
+
+	actual, err := servergroups.GetMemberCount(client.ServiceClient(), "616fb98f-46ca-475e-917e-2563e5a8cd19")
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &servergroups.MemberCount{Members: 3, Limit: 10, Available: 7}, actual)
+}
+
+func TestCheckPlacement(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetWithMembersSuccessfully(t)
+
+	err := servergroups.CheckPlacement(client.ServiceClient(), "616fb98f-46ca-475e-917e-2563e5a8cd19")
+	violation, ok := err.(servergroups.ErrPolicyViolated)
+	if !ok {
+		t.Fatalf("Expected ErrPolicyViolated, got %v", err)
+	}
+	th.AssertEquals(t, servergroups.PolicySoftAntiAffinity, violation.Policy)
+	th.AssertEquals(t, 2, len(violation.Distribution["compute-1"]))
+}
+
+func TestCheckDistribution(t *testing.T) {
+	distribution := map[string][]string{
+		"compute-1": {"a", "b"},
+		"compute-2": {"c"},
+	}
+
+	sg := &servergroups.ServerGroup{
+		Policy: servergroups.PolicyAntiAffinity,
+		Rules:  &servergroups.Rules{MaxServerPerHost: 2},
+	}
+	th.AssertNoErr(t, servergroups.CheckDistribution(sg, distribution))
+
+	sg = &servergroups.ServerGroup{Policies: []string{servergroups.PolicyAffinity}}
+	if err := servergroups.CheckDistribution(sg, distribution); err == nil {
+		t.Fatal("Expected affinity to be violated")
+	}
+
+	th.AssertNoErr(t, servergroups.CheckDistribution(sg, map[string][]string{"compute-1": {"a", "b"}}))
+}
@@ -0,0 +1,173 @@
+package servergroups
+
+import (
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/quotasets"
+	"github.com/chjlangzi/gophercloud/openstack/compute/v2/servers"
+)
+
+// ListByProject returns the server groups owned by a project. It lists the
+// server groups of all projects, which is admin only, and requires
+// microversion 2.13 or later for the project of each group to be known.
+func ListByProject(client *gophercloud.ServiceClient, projectID string) ([]ServerGroup, error) {
+	allPages, err := List(client, ListOpts{AllProjects: true}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allServerGroups, err := ExtractServerGroups(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	var serverGroups []ServerGroup
+	for _, sg := range allServerGroups {
+		if sg.ProjectID == projectID {
+			serverGroups = append(serverGroups, sg)
+		}
+	}
+
+	return serverGroups, nil
+}
+
+// MemberCount is the number of members of a server group compared with the
+// server_group_members quota of its project.
+type MemberCount struct {
+	// Members is the current number of members.
+	Members int
+
+	// Limit is the maximum number of members, or -1 if unlimited.
+	Limit int
+
+	// Available is the number of servers that can still join the group, or
+	// -1 if unlimited.
+	Available int
+}
+
+// GetMemberCount retrieves a server group and the quotas of the project
+// owning it and returns how many more servers can join the group. It
+// requires microversion 2.13 or later for the project of the group to be
+// known.
+func GetMemberCount(client *gophercloud.ServiceClient, id string) (*MemberCount, error) {
+	sg, err := Get(client, id).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	if sg.ProjectID == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "ServerGroup.ProjectID"
+		return nil, err
+	}
+
+	quotaSet, err := quotasets.Get(client, sg.ProjectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	count := &MemberCount{
+		Members:   len(sg.Members),
+		Limit:     quotaSet.ServerGroupMembers,
+		Available: -1,
+	}
+
+	if count.Limit >= 0 {
+		count.Available = count.Limit - count.Members
+		if count.Available < 0 {
+			count.Available = 0
+		}
+	}
+
+	return count, nil
+}
+
+// HostDistribution retrieves every member of a server group and returns the
+// member IDs keyed by the host they run on. The host name is only visible
+// to admins; for other users the obfuscated host ID is used instead, which
+// is just as suitable to compare placements.
+func HostDistribution(client *gophercloud.ServiceClient, sg *ServerGroup) (map[string][]string, error) {
+	distribution := make(map[string][]string)
+	for _, member := range sg.Members {
+		server, err := servers.Get(client, member).Extract()
+		if err != nil {
+			return nil, err
+		}
+
+		host := server.OS_EXT_SRV_ATTR_host
+		if host == "" {
+			host = server.HostID
+		}
+
+		// Members that are not scheduled yet do not count towards any host.
+		if host == "" {
+			continue
+		}
+
+		distribution[host] = append(distribution[host], member)
+	}
+
+	return distribution, nil
+}
+
+// ErrPolicyViolated is the error when the members of a server group are not
+// placed according to its policy.
+type ErrPolicyViolated struct {
+	gophercloud.BaseError
+	Policy       string
+	Distribution map[string][]string
+}
+
+func (e ErrPolicyViolated) Error() string {
+	return fmt.Sprintf("Server group members are not placed according to the %s policy: %v", e.Policy, e.Distribution)
+}
+
+// CheckDistribution verifies that a host distribution returned by
+// HostDistribution satisfies the policy of the server group. The soft
+// policies are checked as strictly as their hard counterparts, since the
+// point is to find out whether they actually hold.
+func CheckDistribution(sg *ServerGroup, distribution map[string][]string) error {
+	policy := sg.EffectivePolicy()
+
+	var ok bool
+	switch policy {
+	case PolicyAffinity, PolicySoftAffinity:
+		ok = len(distribution) <= 1
+	case PolicyAntiAffinity, PolicySoftAntiAffinity:
+		max := 1
+		if sg.Rules != nil && sg.Rules.MaxServerPerHost > 0 {
+			max = sg.Rules.MaxServerPerHost
+		}
+		ok = true
+		for _, members := range distribution {
+			if len(members) > max {
+				ok = false
+			}
+		}
+	default:
+		return fmt.Errorf("Unknown server group policy: %q", policy)
+	}
+
+	if !ok {
+		return ErrPolicyViolated{Policy: policy, Distribution: distribution}
+	}
+
+	return nil
+}
+
+// CheckPlacement retrieves a server group and the hosts of its members and
+// verifies that they are placed according to its policy.
+func CheckPlacement(client *gophercloud.ServiceClient, id string) error {
+	sg, err := Get(client, id).Extract()
+	if err != nil {
+		return err
+	}
+
+	distribution, err := HostDistribution(client, sg)
+	if err != nil {
+		return err
+	}
+
+	return CheckDistribution(sg, distribution)
+}
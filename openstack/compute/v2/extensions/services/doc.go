@@ -17,6 +17,39 @@ Example of Retrieving list of all services
 	for _, service := range allServices {
 		fmt.Printf("%+v\n", service)
 	}
+
+Example of Disabling a Service with a Reason (microversion 2.53 or later)
+
+	computeClient.Microversion = "2.53"
+
+	updateOpts := services.UpdateOpts{
+		Status:         services.ServiceDisabled,
+		DisabledReason: "kernel upgrade",
+	}
+
+	service, err := services.Update(computeClient, "fa4a0ea5-28c8-4bd8-8b40-dcd9cf1ab84a", updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Disabling a Service by Host and Binary (before microversion 2.53)
+
+	hostOpts := services.HostOpts{
+		Host:   "compute-1",
+		Binary: "nova-compute",
+	}
+
+	service, err := services.Disable(computeClient, hostOpts, "kernel upgrade").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Deleting a Service
+
+	err := services.Delete(computeClient, "fa4a0ea5-28c8-4bd8-8b40-dcd9cf1ab84a").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
 */
 
 package services
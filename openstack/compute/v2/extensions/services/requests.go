@@ -11,3 +11,122 @@ func List(client *gophercloud.ServiceClient) pagination.Pager {
 		return ServicePage{pagination.SinglePageBase(r)}
 	})
 }
+
+// ServiceStatus is the administrative status of a service.
+type ServiceStatus string
+
+const (
+	// ServiceEnabled is the status of a service that accepts work.
+	ServiceEnabled ServiceStatus = "enabled"
+
+	// ServiceDisabled is the status of a service that does not accept work.
+	ServiceDisabled ServiceStatus = "disabled"
+)
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToServiceUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies the attributes of a service to update.
+type UpdateOpts struct {
+	// Status enables or disables the service.
+	Status ServiceStatus `json:"status,omitempty"`
+
+	// DisabledReason is the reason for disabling the service. It can only
+	// be set together with a disabled Status.
+	DisabledReason string `json:"disabled_reason,omitempty"`
+
+	// ForcedDown marks the service as down without waiting for its
+	// heartbeat to time out, which allows evacuating its servers.
+	ForcedDown *bool `json:"forced_down,omitempty"`
+}
+
+// ToServiceUpdateMap formats an UpdateOpts structure into a request body.
+func (opts UpdateOpts) ToServiceUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update updates a service identified by its UUID.
+// Requires microversion 2.53 or later.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToServiceUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete deletes a service identified by its ID. It is a UUID with
+// microversion 2.53 or later.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = client.Delete(deleteURL(client, id), nil)
+	return
+}
+
+// HostOpts identifies a service by host and binary, which is how services
+// are addressed before microversion 2.53.
+type HostOpts struct {
+	// Host is the name of the host the service runs on.
+	Host string `json:"host" required:"true"`
+
+	// Binary is the name of the service binary, e.g. "nova-compute".
+	Binary string `json:"binary" required:"true"`
+}
+
+// Enable enables the service running on a host.
+func Enable(client *gophercloud.ServiceClient, opts HostOpts) (r UpdateResult) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	_, r.Err = client.Put(actionURL(client, "enable"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Disable disables the service running on a host, recording the reason if
+// one is given.
+func Disable(client *gophercloud.ServiceClient, opts HostOpts, reason string) (r UpdateResult) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	action := "disable"
+	if reason != "" {
+		action = "disable-log-reason"
+		b["disabled_reason"] = reason
+	}
+
+	_, r.Err = client.Put(actionURL(client, action), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// ForceDown sets or clears the forced down flag of the service running on
+// a host. Requires microversion 2.11 or later.
+func ForceDown(client *gophercloud.ServiceClient, opts HostOpts, forcedDown bool) (r UpdateResult) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		r.Err = err
+		return
+	}
+	b["forced_down"] = forcedDown
+
+	_, r.Err = client.Put(actionURL(client, "force-down"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
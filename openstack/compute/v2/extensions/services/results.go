@@ -2,6 +2,8 @@ package services
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/chjlangzi/gophercloud"
@@ -19,8 +21,9 @@ type Service struct {
 	// The name of the host.
 	Host string `json:"host"`
 
-	// The id of the service.
-	ID int `json:"id"`
+	// The id of the service. It is an integer rendered as a string before
+	// microversion 2.53 and a UUID afterwards.
+	ID string `json:"-"`
 
 	// The state of the service. One of up or down.
 	State string `json:"state"`
@@ -28,6 +31,10 @@ type Service struct {
 	// The status of the service. One of enabled or disabled.
 	Status string `json:"status"`
 
+	// Whether the service has been forced down.
+	// Requires microversion 2.11 or later.
+	ForcedDown bool `json:"forced_down"`
+
 	// The date and time when the resource was updated.
 	UpdatedAt time.Time `json:"-"`
 
@@ -40,6 +47,7 @@ func (r *Service) UnmarshalJSON(b []byte) error {
 	type tmp Service
 	var s struct {
 		tmp
+		ID        interface{}                     `json:"id"`
 		UpdatedAt gophercloud.JSONRFC3339MilliNoZ `json:"updated_at"`
 	}
 	err := json.Unmarshal(b, &s)
@@ -50,6 +58,18 @@ func (r *Service) UnmarshalJSON(b []byte) error {
 
 	r.UpdatedAt = time.Time(s.UpdatedAt)
 
+	// Services have an integer ID before microversion 2.53 and a UUID
+	// afterwards.
+	switch t := s.ID.(type) {
+	case nil:
+	case float64:
+		r.ID = strconv.FormatInt(int64(t), 10)
+	case string:
+		r.ID = t
+	default:
+		return fmt.Errorf("ID has unexpected type: %T", t)
+	}
+
 	return nil
 }
 
@@ -71,3 +91,30 @@ func ExtractServices(r pagination.Page) ([]Service, error) {
 	err := (r.(ServicePage)).ExtractInto(&s)
 	return s.Service, err
 }
+
+type serviceResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any serviceResult as a Service. Before microversion
+// 2.53 only the host, binary, status, disabled reason and forced down fields
+// are returned.
+func (r serviceResult) Extract() (*Service, error) {
+	var s struct {
+		Service Service `json:"service"`
+	}
+	err := r.ExtractInto(&s)
+	return &s.Service, err
+}
+
+// UpdateResult is the response from an Update, Enable, Disable or ForceDown
+// operation. Call its Extract method to interpret it as a Service.
+type UpdateResult struct {
+	serviceResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -68,7 +68,7 @@ var FirstFakeService = services.Service{
 	Binary:         "nova-scheduler",
 	DisabledReason: "test1",
 	Host:           "host1",
-	ID:             1,
+	ID:             "1",
 	State:          "up",
 	Status:         "disabled",
 	UpdatedAt:      time.Date(2012, 10, 29, 13, 42, 2, 0, time.UTC),
@@ -80,7 +80,7 @@ var SecondFakeService = services.Service{
 	Binary:         "nova-compute",
 	DisabledReason: "test2",
 	Host:           "host1",
-	ID:             2,
+	ID:             "2",
 	State:          "up",
 	Status:         "disabled",
 	UpdatedAt:      time.Date(2012, 10, 29, 13, 42, 5, 0, time.UTC),
@@ -92,7 +92,7 @@ var ThirdFakeService = services.Service{
 	Binary:         "nova-scheduler",
 	DisabledReason: "",
 	Host:           "host2",
-	ID:             3,
+	ID:             "3",
 	State:          "down",
 	Status:         "enabled",
 	UpdatedAt:      time.Date(2012, 9, 19, 6, 55, 34, 0, time.UTC),
@@ -104,7 +104,7 @@ var FourthFakeService = services.Service{
 	Binary:         "nova-compute",
 	DisabledReason: "test4",
 	Host:           "host2",
-	ID:             4,
+	ID:             "4",
 	State:          "down",
 	Status:         "disabled",
 	UpdatedAt:      time.Date(2012, 9, 18, 8, 3, 38, 0, time.UTC),
@@ -121,3 +121,140 @@ func HandleListSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, ServiceListBody)
 	})
 }
+
+// ServiceUpdateRequest is the expected body of an Update call.
+const ServiceUpdateRequest = `
+{
+    "status": "disabled",
+    "disabled_reason": "maintenance",
+    "forced_down": true
+}
+`
+
+// ServiceUpdateBody is sample response to the Update call.
+const ServiceUpdateBody = `
+{
+    "service": {
+        "id": "fa4a0ea5-28c8-4bd8-8b40-dcd9cf1ab84a",
+        "binary": "nova-compute",
+        "disabled_reason": "maintenance",
+        "host": "host1",
+        "state": "up",
+        "status": "disabled",
+        "updated_at": "2012-10-29T13:42:05.000000",
+        "forced_down": true,
+        "zone": "nova"
+    }
+}
+`
+
+// FakeServiceUpdateBody is the service returned by ServiceUpdateBody.
+var FakeServiceUpdateBody = services.Service{
+	Binary:         "nova-compute",
+	DisabledReason: "maintenance",
+	ForcedDown:     true,
+	Host:           "host1",
+	ID:             "fa4a0ea5-28c8-4bd8-8b40-dcd9cf1ab84a",
+	State:          "up",
+	Status:         "disabled",
+	UpdatedAt:      time.Date(2012, 10, 29, 13, 42, 5, 0, time.UTC),
+	Zone:           "nova",
+}
+
+// ServiceDisableRequest is the expected body of a Disable call with a
+// reason.
+const ServiceDisableRequest = `
+{
+    "host": "host1",
+    "binary": "nova-compute",
+    "disabled_reason": "maintenance"
+}
+`
+
+// ServiceDisableBody is sample response to the Disable call.
+const ServiceDisableBody = `
+{
+    "service": {
+        "binary": "nova-compute",
+        "disabled_reason": "maintenance",
+        "host": "host1",
+        "status": "disabled"
+    }
+}
+`
+
+// ServiceEnableRequest is the expected body of an Enable call.
+const ServiceEnableRequest = `
+{
+    "host": "host1",
+    "binary": "nova-compute"
+}
+`
+
+// ServiceEnableBody is sample response to the Enable call.
+const ServiceEnableBody = `
+{
+    "service": {
+        "binary": "nova-compute",
+        "host": "host1",
+        "status": "enabled"
+    }
+}
+`
+
+// ServiceForceDownRequest is the expected body of a ForceDown call.
+const ServiceForceDownRequest = `
+{
+    "host": "host1",
+    "binary": "nova-compute",
+    "forced_down": true
+}
+`
+
+// ServiceForceDownBody is sample response to the ForceDown call.
+const ServiceForceDownBody = `
+{
+    "service": {
+        "binary": "nova-compute",
+        "host": "host1",
+        "forced_down": true
+    }
+}
+`
+
+// HandleUpdateSuccessfully configures the test server to respond to an
+// Update request.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services/fa4a0ea5-28c8-4bd8-8b40-dcd9cf1ab84a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, ServiceUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, ServiceUpdateBody)
+	})
+}
+
+// HandleDeleteSuccessfully configures the test server to respond to a
+// Delete request.
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-services/fa4a0ea5-28c8-4bd8-8b40-dcd9cf1ab84a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// HandleActionSuccessfully configures the test server to respond to an
+// Enable, Disable or ForceDown request.
+func HandleActionSuccessfully(t *testing.T, action, request, response string) {
+	th.Mux.HandleFunc("/os-services/"+action, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, request)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, response)
+	})
+}
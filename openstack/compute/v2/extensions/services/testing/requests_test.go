@@ -40,3 +40,63 @@ func TestListServices(t *testing.T) {
 		t.Errorf("Expected 1 page, saw %d", pages)
 	}
 }
+
+func TestUpdateService(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleUpdateSuccessfully(t)
+
+	forcedDown := true
+	opts := services.UpdateOpts{
+		Status:         services.ServiceDisabled,
+		DisabledReason: "maintenance",
+		ForcedDown:     &forcedDown,
+	}
+
+	actual, err := services.Update(client.ServiceClient(), "fa4a0ea5-28c8-4bd8-8b40-dcd9cf1ab84a", opts).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.CheckDeepEquals(t, FakeServiceUpdateBody, *actual)
+}
+
+func TestDeleteService(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleDeleteSuccessfully(t)
+
+	err := services.Delete(client.ServiceClient(), "fa4a0ea5-28c8-4bd8-8b40-dcd9cf1ab84a").ExtractErr()
+	testhelper.AssertNoErr(t, err)
+}
+
+func TestEnableService(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleActionSuccessfully(t, "enable", ServiceEnableRequest, ServiceEnableBody)
+
+	opts := services.HostOpts{Host: "host1", Binary: "nova-compute"}
+	actual, err := services.Enable(client.ServiceClient(), opts).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "enabled", actual.Status)
+}
+
+func TestDisableServiceWithReason(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleActionSuccessfully(t, "disable-log-reason", ServiceDisableRequest, ServiceDisableBody)
+
+	opts := services.HostOpts{Host: "host1", Binary: "nova-compute"}
+	actual, err := services.Disable(client.ServiceClient(), opts, "maintenance").Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, "disabled", actual.Status)
+	testhelper.AssertEquals(t, "maintenance", actual.DisabledReason)
+}
+
+func TestForceDownService(t *testing.T) {
+	testhelper.SetupHTTP()
+	defer testhelper.TeardownHTTP()
+	HandleActionSuccessfully(t, "force-down", ServiceForceDownRequest, ServiceForceDownBody)
+
+	opts := services.HostOpts{Host: "host1", Binary: "nova-compute"}
+	actual, err := services.ForceDown(client.ServiceClient(), opts, true).Extract()
+	testhelper.AssertNoErr(t, err)
+	testhelper.AssertEquals(t, true, actual.ForcedDown)
+}
@@ -5,3 +5,15 @@ import "github.com/chjlangzi/gophercloud"
 func listURL(c *gophercloud.ServiceClient) string {
 	return c.ServiceURL("os-services")
 }
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("os-services", id)
+}
+
+func actionURL(c *gophercloud.ServiceClient, action string) string {
+	return c.ServiceURL("os-services", action)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("os-services", id)
+}
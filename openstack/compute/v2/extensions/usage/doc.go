@@ -23,5 +23,37 @@ Example to Retrieve Usage for a Single Tenant:
 
     fmt.Printf("%+v\n", tenantUsage)
 
+Example to Retrieve Usage for All Tenants:
+	allTenantsOpts := usage.AllTenantsOpts{
+		Detailed: true,
+	}
+
+	page, err := usage.AllTenants(computeClient, allTenantsOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTenantsUsage, err := usage.ExtractAllTenants(page)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%+v\n", allTenantsUsage)
+
+Example to Aggregate Usage per Tenant over a Time Window:
+	start := time.Date(2017, 11, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2017, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	computeClient.Microversion = "2.40"
+	totals, err := usage.AllTenantsTotals(computeClient, start, end)
+	if err != nil {
+		panic(err)
+	}
+
+	for tenantID, t := range totals {
+		fmt.Printf("%s: %f vCPU-hours, %f RAM-MB-hours, %f disk-GB-hours\n",
+			tenantID, t.VCPUHours, t.MemoryMBHours, t.DiskGBHours)
+	}
+
 */
 package usage
@@ -2,6 +2,7 @@ package usage
 
 import (
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/chjlangzi/gophercloud"
@@ -52,3 +53,71 @@ func (opts SingleTenantOpts) ToUsageSingleTenantQuery() (string, error) {
 	q := &url.URL{RawQuery: params.Encode()}
 	return q.String(), nil
 }
+
+// AllTenants returns usage data about all tenants.
+func AllTenants(client *gophercloud.ServiceClient, opts AllTenantsOptsBuilder) pagination.Pager {
+	url := allTenantsURL(client)
+	if opts != nil {
+		query, err := opts.ToUsageAllTenantsQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return AllTenantsPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// AllTenantsOpts are options for fetching usage of all tenants.
+type AllTenantsOpts struct {
+	// Detailed will return the usage of each server of each tenant.
+	Detailed bool `q:"detailed"`
+
+	// The ending time to calculate usage statistics on compute and storage resources.
+	End *time.Time `q:"end"`
+
+	// The beginning time to calculate usage statistics on compute and storage resources.
+	Start *time.Time `q:"start"`
+
+	// Limit limits the number of server usages returned per page.
+	// Requires microversion 2.40 or later.
+	Limit int `q:"limit"`
+
+	// Marker is the ID of the last server usage seen, and instructs the API
+	// where to start listing from. Requires microversion 2.40 or later.
+	Marker string `q:"marker"`
+}
+
+// AllTenantsOptsBuilder allows extensions to add additional parameters to the
+// AllTenants request.
+type AllTenantsOptsBuilder interface {
+	ToUsageAllTenantsQuery() (string, error)
+}
+
+// ToUsageAllTenantsQuery formats an AllTenantsOpts into a query string.
+func (opts AllTenantsOpts) ToUsageAllTenantsQuery() (string, error) {
+	params := make(url.Values)
+	if opts.Start != nil {
+		params.Add("start", opts.Start.Format(gophercloud.RFC3339MilliNoZ))
+	}
+
+	if opts.End != nil {
+		params.Add("end", opts.End.Format(gophercloud.RFC3339MilliNoZ))
+	}
+
+	if opts.Detailed {
+		params.Add("detailed", "1")
+	}
+
+	if opts.Limit != 0 {
+		params.Add("limit", strconv.Itoa(opts.Limit))
+	}
+
+	if opts.Marker != "" {
+		params.Add("marker", opts.Marker)
+	}
+
+	q := &url.URL{RawQuery: params.Encode()}
+	return q.String(), nil
+}
@@ -135,3 +135,40 @@ func ExtractSingleTenant(page pagination.Page) (*TenantUsage, error) {
 	err := (page.(SingleTenantPage)).ExtractInto(&s)
 	return s.TenantUsage, err
 }
+
+// AllTenantsPage stores a single page of TenantUsage results from an
+// AllTenants call.
+type AllTenantsPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty determines whether or not an AllTenantsPage is empty.
+func (page AllTenantsPage) IsEmpty() (bool, error) {
+	usages, err := ExtractAllTenants(page)
+	return len(usages) == 0, err
+}
+
+// NextPageURL uses the response's embedded link reference to navigate to the
+// next page of results.
+func (page AllTenantsPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"tenant_usages_links"`
+	}
+	err := page.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// ExtractAllTenants interprets an AllTenantsPage as a slice of TenantUsages.
+// When paginating with microversion 2.40 or later, the usage of a single
+// tenant may be split across several pages; use AggregateByTenant to combine
+// them.
+func ExtractAllTenants(page pagination.Page) ([]TenantUsage, error) {
+	var s struct {
+		TenantUsages []TenantUsage `json:"tenant_usages"`
+	}
+	err := (page.(AllTenantsPage)).ExtractInto(&s)
+	return s.TenantUsages, err
+}
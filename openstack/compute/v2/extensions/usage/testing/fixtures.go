@@ -135,3 +135,150 @@ var SingleTenantUsageResults = usage.TenantUsage{
 	TotalMemoryMBUsage: 644.27116544,
 	TotalVCPUsUsage:    1.25834212,
 }
+
+// SecondTenantID is the ID of the second tenant in the AllTenants fixtures.
+const SecondTenantID = "40cedb68e8d24a9b8d5a24c1a2d6d3b1"
+
+// GetAllTenantsPage holds the fixtures for the first page of the content of
+// the request for all tenants.
+const GetAllTenantsPage = `{
+    "tenant_usages": [
+        {
+            "server_usages": [
+                {
+                    "ended_at": null,
+                    "flavor": "m1.tiny",
+                    "hours": 0.021675453333333334,
+                    "instance_id": "a70096fd-8196-406b-86c4-045840f53ad7",
+                    "local_gb": 1,
+                    "memory_mb": 512,
+                    "name": "jttest",
+                    "started_at": "2017-11-30T03:23:43.000000",
+                    "state": "active",
+                    "tenant_id": "aabbccddeeff112233445566",
+                    "uptime": 78,
+                    "vcpus": 1
+                }
+            ],
+            "start": "2017-11-02T03:25:01.000000",
+            "stop": "2017-11-30T03:25:01.000000",
+            "tenant_id": "aabbccddeeff112233445566",
+            "total_hours": 1.5,
+            "total_local_gb_usage": 10.5,
+            "total_memory_mb_usage": 768.0,
+            "total_vcpus_usage": 1.5
+        },
+        {
+            "server_usages": [
+                {
+                    "ended_at": "2017-11-21T04:10:11.000000",
+                    "flavor": "m1.acctest",
+                    "hours": 0.33444444444444443,
+                    "instance_id": "c04e38f2-dcee-4ca8-9466-7708d0a9b6dd",
+                    "local_gb": 15,
+                    "memory_mb": 512,
+                    "name": "basic",
+                    "started_at": "2017-11-21T03:50:07.000000",
+                    "state": "terminated",
+                    "tenant_id": "40cedb68e8d24a9b8d5a24c1a2d6d3b1",
+                    "uptime": 1204,
+                    "vcpus": 1
+                }
+            ],
+            "start": "2017-11-02T03:25:01.000000",
+            "stop": "2017-11-30T03:25:01.000000",
+            "tenant_id": "40cedb68e8d24a9b8d5a24c1a2d6d3b1",
+            "total_hours": 2.0,
+            "total_local_gb_usage": 30.0,
+            "total_memory_mb_usage": 1024.0,
+            "total_vcpus_usage": 2.0
+        }
+    ],
+    "tenant_usages_links": [
+        {
+            "href": "%s/os-simple-tenant-usage?detailed=1&end=2017-11-30T03%%3A25%%3A01&limit=2&marker=c04e38f2-dcee-4ca8-9466-7708d0a9b6dd&start=2017-11-02T03%%3A25%%3A01",
+            "rel": "next"
+        }
+    ]
+}`
+
+// GetAllTenantsSecondPage holds the fixtures for the second page of the
+// content of the request for all tenants.
+const GetAllTenantsSecondPage = `{
+    "tenant_usages": [
+        {
+            "server_usages": [
+                {
+                    "ended_at": "2017-11-30T03:21:21.000000",
+                    "flavor": "m1.acctest",
+                    "hours": 0.004166666666666667,
+                    "instance_id": "ceb654fa-e0e8-44fb-8942-e4d0bfad3941",
+                    "local_gb": 15,
+                    "memory_mb": 512,
+                    "name": "ACPTTESTJSxbPQAC34lTnBE1",
+                    "started_at": "2017-11-30T03:21:06.000000",
+                    "state": "terminated",
+                    "tenant_id": "aabbccddeeff112233445566",
+                    "uptime": 15,
+                    "vcpus": 1
+                }
+            ],
+            "start": "2017-11-02T03:25:01.000000",
+            "stop": "2017-11-30T03:25:01.000000",
+            "tenant_id": "aabbccddeeff112233445566",
+            "total_hours": 0.5,
+            "total_local_gb_usage": 7.5,
+            "total_memory_mb_usage": 256.0,
+            "total_vcpus_usage": 0.5
+        }
+    ]
+}`
+
+// HandleGetAllTenantsSuccessfully configures the test server to respond to a
+// Get request for all tenants.
+func HandleGetAllTenantsSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/os-simple-tenant-usage", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+
+		r.ParseForm()
+		th.CheckEquals(t, "1", r.Form.Get("detailed"))
+		th.CheckEquals(t, "2017-11-02T03:25:01", r.Form.Get("start"))
+		th.CheckEquals(t, "2017-11-30T03:25:01", r.Form.Get("end"))
+
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			fmt.Fprintf(w, GetAllTenantsPage, th.Server.URL)
+		case "c04e38f2-dcee-4ca8-9466-7708d0a9b6dd":
+			fmt.Fprint(w, GetAllTenantsSecondPage)
+		case "ceb654fa-e0e8-44fb-8942-e4d0bfad3941":
+			fmt.Fprint(w, `{"tenant_usages": []}`)
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+// ExpectedTotals is the result of aggregating all pages of the AllTenants
+// fixtures by tenant.
+var ExpectedTotals = map[string]usage.TenantTotals{
+	FirstTenantID: {
+		TenantID:      FirstTenantID,
+		Start:         time.Date(2017, 11, 2, 3, 25, 1, 0, time.UTC),
+		Stop:          time.Date(2017, 11, 30, 3, 25, 1, 0, time.UTC),
+		ServerHours:   2.0,
+		VCPUHours:     2.0,
+		MemoryMBHours: 1024.0,
+		DiskGBHours:   18.0,
+	},
+	SecondTenantID: {
+		TenantID:      SecondTenantID,
+		Start:         time.Date(2017, 11, 2, 3, 25, 1, 0, time.UTC),
+		Stop:          time.Date(2017, 11, 30, 3, 25, 1, 0, time.UTC),
+		ServerHours:   2.0,
+		VCPUHours:     2.0,
+		MemoryMBHours: 1024.0,
+		DiskGBHours:   30.0,
+	},
+}
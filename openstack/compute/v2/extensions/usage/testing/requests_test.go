@@ -2,8 +2,10 @@ package testing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/chjlangzi/gophercloud/openstack/compute/v2/extensions/usage"
+	"github.com/chjlangzi/gophercloud/pagination"
 	th "github.com/chjlangzi/gophercloud/testhelper"
 	"github.com/chjlangzi/gophercloud/testhelper/client"
 )
@@ -19,3 +21,63 @@ func TestGetTenant(t *testing.T) {
 	th.AssertNoErr(t, err)
 	th.CheckDeepEquals(t, &SingleTenantUsageResults, actual)
 }
+
+func TestAllTenants(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetAllTenantsSuccessfully(t)
+
+	start := time.Date(2017, 11, 2, 3, 25, 1, 0, time.UTC)
+	end := time.Date(2017, 11, 30, 3, 25, 1, 0, time.UTC)
+	getOpts := usage.AllTenantsOpts{
+		Detailed: true,
+		Start:    &start,
+		End:      &end,
+	}
+
+	count := 0
+	err := usage.AllTenants(client.ServiceClient(), getOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := usage.ExtractAllTenants(page)
+		th.AssertNoErr(t, err)
+
+		switch count {
+		case 1:
+			th.AssertEquals(t, 2, len(actual))
+			th.AssertEquals(t, FirstTenantID, actual[0].TenantID)
+			th.AssertEquals(t, SecondTenantID, actual[1].TenantID)
+			th.AssertEquals(t, "jttest", actual[0].ServerUsages[0].Name)
+		case 2:
+			th.AssertEquals(t, 1, len(actual))
+			th.AssertEquals(t, FirstTenantID, actual[0].TenantID)
+		}
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, count)
+}
+
+func TestAllTenantsAggregateByTenant(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleGetAllTenantsSuccessfully(t)
+
+	start := time.Date(2017, 11, 2, 3, 25, 1, 0, time.UTC)
+	end := time.Date(2017, 11, 30, 3, 25, 1, 0, time.UTC)
+	getOpts := usage.AllTenantsOpts{
+		Detailed: true,
+		Start:    &start,
+		End:      &end,
+	}
+
+	allPages, err := usage.AllTenants(client.ServiceClient(), getOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	allUsages, err := usage.ExtractAllTenants(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 3, len(allUsages))
+
+	th.CheckDeepEquals(t, ExpectedTotals, usage.AggregateByTenant(allUsages))
+}
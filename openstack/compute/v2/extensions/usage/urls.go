@@ -4,7 +4,7 @@ import "github.com/chjlangzi/gophercloud"
 
 const resourcePath = "os-simple-tenant-usage"
 
-func getURL(client *gophercloud.ServiceClient) string {
+func allTenantsURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL(resourcePath)
 }
 
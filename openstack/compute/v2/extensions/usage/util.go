@@ -0,0 +1,86 @@
+package usage
+
+import (
+	"time"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// TenantTotals is the aggregated usage of a single tenant over a time window.
+type TenantTotals struct {
+	// TenantID is the ID of the tenant.
+	TenantID string
+
+	// Start and Stop delimit the time window the usage was calculated on.
+	Start time.Time
+	Stop  time.Time
+
+	// ServerHours is the total number of hours servers of the tenant existed.
+	ServerHours float64
+
+	// VCPUHours is the sum of the number of vCPUs of each server multiplied by
+	// the hours the server existed.
+	VCPUHours float64
+
+	// MemoryMBHours is the sum of the memory size (in MB) of each server
+	// multiplied by the hours the server existed.
+	MemoryMBHours float64
+
+	// DiskGBHours is the sum of the disk size (in GiB) of each server
+	// multiplied by the hours the server existed.
+	DiskGBHours float64
+}
+
+// AggregateByTenant combines TenantUsages into per-tenant totals, keyed by
+// tenant ID. TenantUsages of the same tenant, such as those spread across
+// the pages of an AllTenants call, are summed.
+func AggregateByTenant(usages []TenantUsage) map[string]TenantTotals {
+	totals := make(map[string]TenantTotals)
+	for _, u := range usages {
+		t, ok := totals[u.TenantID]
+		if !ok {
+			t = TenantTotals{
+				TenantID: u.TenantID,
+				Start:    u.Start,
+				Stop:     u.Stop,
+			}
+		}
+
+		if u.Start.Before(t.Start) {
+			t.Start = u.Start
+		}
+		if u.Stop.After(t.Stop) {
+			t.Stop = u.Stop
+		}
+
+		t.ServerHours += u.TotalHours
+		t.VCPUHours += u.TotalVCPUsUsage
+		t.MemoryMBHours += u.TotalMemoryMBUsage
+		t.DiskGBHours += u.TotalLocalGBUsage
+
+		totals[u.TenantID] = t
+	}
+
+	return totals
+}
+
+// AllTenantsTotals retrieves the usage of all tenants between start and end,
+// following every page, and returns the per-tenant totals keyed by tenant ID.
+func AllTenantsTotals(client *gophercloud.ServiceClient, start, end time.Time) (map[string]TenantTotals, error) {
+	opts := AllTenantsOpts{
+		Start: &start,
+		End:   &end,
+	}
+
+	allPages, err := AllTenants(client, opts).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	allUsages, err := ExtractAllTenants(allPages)
+	if err != nil {
+		return nil, err
+	}
+
+	return AggregateByTenant(allUsages), nil
+}
@@ -17,6 +17,39 @@ Example to Attach a Volume
 		panic(err)
 	}
 
+Example to Attach a Tagged Volume and Wait for the Attachment
+
+	computeClient.Microversion = "2.79"
+
+	createOpts := volumeattach.CreateOpts{
+		VolumeID:            volumeID,
+		Tag:                 "data",
+		DeleteOnTermination: true,
+	}
+
+	details, err := volumeattach.AttachAndWait(computeClient, blockStorageClient, serverID, createOpts, 120)
+	if err != nil {
+		panic(err)
+	}
+
+	if details.Multiattach {
+		fmt.Printf("Volume is attached to %d servers\n", len(details.ServerIDs))
+	}
+
+Example to Swap an Attached Volume
+
+	serverID := "7ac8686c-de71-4acb-9600-ec18b1a1ed6d"
+	volumeID := "87463836-f0e2-4029-abf6-20c8892a3103"
+
+	updateOpts := volumeattach.UpdateOpts{
+		VolumeID: "1e8c1f24-5bd8-4e0f-9e3a-33c6a1f0a9b4",
+	}
+
+	err := volumeattach.Update(computeClient, serverID, volumeID, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Detach a Volume
 
 	serverID := "7ac8686c-de71-4acb-9600-ec18b1a1ed6d"
@@ -0,0 +1,20 @@
+package volumeattach
+
+import (
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrAttachFailed is returned by AttachAndWait when the volume enters an
+// error state while being attached.
+type ErrAttachFailed struct {
+	gophercloud.BaseError
+	ServerID string
+	VolumeID string
+	Status   string
+}
+
+func (e ErrAttachFailed) Error() string {
+	return fmt.Sprintf("Volume [%s] entered status [%s] while attaching to server [%s]", e.VolumeID, e.Status, e.ServerID)
+}
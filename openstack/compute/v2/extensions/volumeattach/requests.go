@@ -26,6 +26,14 @@ type CreateOpts struct {
 
 	// VolumeID is the ID of the volume to attach to the instance.
 	VolumeID string `json:"volumeId" required:"true"`
+
+	// Tag is a device role tag that can be applied to a volume when attaching
+	// it to the server. Requires microversion 2.49 or later.
+	Tag string `json:"tag,omitempty"`
+
+	// DeleteOnTermination specifies whether or not to delete the volume when
+	// the server is destroyed. Requires microversion 2.79 or later.
+	DeleteOnTermination bool `json:"delete_on_termination,omitempty"`
 }
 
 // ToVolumeAttachmentCreateMap constructs a request body from CreateOpts.
@@ -46,6 +54,43 @@ func Create(client *gophercloud.ServiceClient, serverID string, opts CreateOptsB
 	return
 }
 
+// UpdateOptsBuilder allows extensions to add parameters to the Update request.
+type UpdateOptsBuilder interface {
+	ToVolumeAttachmentUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies volume attachment update parameters.
+type UpdateOpts struct {
+	// VolumeID is the ID of the volume to attach to the instance. When it
+	// differs from the attached volume, the data of the attached volume is
+	// copied to it and the volumes are swapped. Swapping is admin only.
+	VolumeID string `json:"volumeId" required:"true"`
+
+	// DeleteOnTermination specifies whether or not to delete the volume when
+	// the server is destroyed. Requires microversion 2.85 or later.
+	DeleteOnTermination *bool `json:"delete_on_termination,omitempty"`
+}
+
+// ToVolumeAttachmentUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToVolumeAttachmentUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "volumeAttachment")
+}
+
+// Update updates the volume attachment of the given volume on the server,
+// swapping it for another volume or, with microversion 2.85 or later,
+// changing its delete_on_termination flag.
+func Update(client *gophercloud.ServiceClient, serverID, volumeID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToVolumeAttachmentUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, serverID, volumeID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
 // Get returns public data about a previously created VolumeAttachment.
 func Get(client *gophercloud.ServiceClient, serverID, attachmentID string) (r GetResult) {
 	_, r.Err = client.Get(getURL(client, serverID, attachmentID), &r.Body, nil)
@@ -19,6 +19,23 @@ type VolumeAttachment struct {
 
 	// ServerID is the ID of the instance that has the volume attached.
 	ServerID string `json:"serverId"`
+
+	// Tag is the device role tag of the attachment.
+	// Requires microversion 2.70 or later.
+	Tag string `json:"tag"`
+
+	// DeleteOnTermination specifies whether or not the volume is deleted
+	// when the server is destroyed. Requires microversion 2.79 or later.
+	DeleteOnTermination bool `json:"delete_on_termination"`
+
+	// AttachmentID is the ID of the Block Storage attachment. A volume
+	// attached to several servers has one attachment per server.
+	// Requires microversion 2.89 or later.
+	AttachmentID string `json:"attachment_id"`
+
+	// BDMUUID is the UUID of the block device mapping of the attachment.
+	// Requires microversion 2.89 or later.
+	BDMUUID string `json:"bdm_uuid"`
 }
 
 // VolumeAttachmentPage stores a single page all of VolumeAttachment
@@ -70,6 +87,12 @@ type GetResult struct {
 	VolumeAttachmentResult
 }
 
+// UpdateResult is the response from an Update operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
+
 // DeleteResult is the response from a Delete operation. Call its ExtractErr
 // method to determine if the call succeeded or failed.
 type DeleteResult struct {
@@ -106,3 +106,124 @@ func HandleDeleteSuccessfully(t *testing.T) {
 		w.WriteHeader(http.StatusAccepted)
 	})
 }
+
+// GetTaggedOutput is a sample response to a Get call at microversion 2.89.
+const GetTaggedOutput = `
+{
+  "volumeAttachment": {
+    "device": "/dev/vdb",
+    "id": "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+    "serverId": "4d8c3732-a248-40ed-bebc-539a6ffd25c0",
+    "volumeId": "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+    "tag": "data",
+    "delete_on_termination": true,
+    "attachment_id": "979ce4f8-033a-409d-85e6-6b5c0f6a6302",
+    "bdm_uuid": "c088db45-92b8-49e8-81e2-a1b77a144b3b"
+  }
+}
+`
+
+// VolumeInUseOutput is a sample Block Storage response for a multiattach
+// volume attached to two servers.
+const VolumeInUseOutput = `
+{
+  "volume": {
+    "id": "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+    "status": "in-use",
+    "multiattach": true,
+    "attachments": [
+      {
+        "server_id": "1a1f5a2e-7d5e-4b8c-9f11-ccbdb2a3b6d1",
+        "attachment_id": "4fbf4a5d-7b6b-43d4-b7b4-23fa5b4a3c11",
+        "volume_id": "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+        "device": "/dev/vdb",
+        "id": "a26887c6-c47b-4654-abb5-dfadf7d3f805"
+      },
+      {
+        "server_id": "4d8c3732-a248-40ed-bebc-539a6ffd25c0",
+        "attachment_id": "979ce4f8-033a-409d-85e6-6b5c0f6a6302",
+        "volume_id": "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+        "device": "/dev/vdb",
+        "id": "a26887c6-c47b-4654-abb5-dfadf7d3f805"
+      }
+    ]
+  }
+}
+`
+
+// VolumeErrorOutput is a sample Block Storage response for a volume that
+// failed to attach.
+const VolumeErrorOutput = `
+{
+  "volume": {
+    "id": "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+    "status": "error_attaching",
+    "multiattach": false,
+    "attachments": []
+  }
+}
+`
+
+// HandleCreateTaggedSuccessfully configures the test server to respond to a
+// Create request with a tag and delete_on_termination.
+func HandleCreateTaggedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/4d8c3732-a248-40ed-bebc-539a6ffd25c0/os-volume_attachments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+  "volumeAttachment": {
+    "volumeId": "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+    "tag": "data",
+    "delete_on_termination": true
+  }
+}
+`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetTaggedOutput)
+	})
+}
+
+// HandleGetTaggedSuccessfully configures the test server to respond to a Get
+// request for the tagged attachment.
+func HandleGetTaggedSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/4d8c3732-a248-40ed-bebc-539a6ffd25c0/os-volume_attachments/a26887c6-c47b-4654-abb5-dfadf7d3f805", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, GetTaggedOutput)
+	})
+}
+
+// HandleUpdateSuccessfully configures the test server to respond to an Update
+// request swapping an attached volume.
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers/4d8c3732-a248-40ed-bebc-539a6ffd25c0/os-volume_attachments/a26887c6-c47b-4654-abb5-dfadf7d3f804", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+  "volumeAttachment": {
+    "volumeId": "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+    "delete_on_termination": false
+  }
+}
+`)
+
+		w.WriteHeader(http.StatusAccepted)
+	})
+}
+
+// HandleVolumeGetSuccessfully configures the test server to respond to a
+// Block Storage volume Get request with the given body.
+func HandleVolumeGetSuccessfully(t *testing.T, output string) {
+	th.Mux.HandleFunc("/volumes/a26887c6-c47b-4654-abb5-dfadf7d3f805", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, output)
+	})
+}
@@ -37,6 +37,18 @@ var CreatedVolumeAttachment = volumeattach.VolumeAttachment{
 	VolumeID: "a26887c6-c47b-4654-abb5-dfadf7d3f804",
 }
 
+// TaggedVolumeAttachment is the parsed result from GetTaggedOutput.
+var TaggedVolumeAttachment = volumeattach.VolumeAttachment{
+	Device:              "/dev/vdb",
+	ID:                  "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+	ServerID:            "4d8c3732-a248-40ed-bebc-539a6ffd25c0",
+	VolumeID:            "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+	Tag:                 "data",
+	DeleteOnTermination: true,
+	AttachmentID:        "979ce4f8-033a-409d-85e6-6b5c0f6a6302",
+	BDMUUID:             "c088db45-92b8-49e8-81e2-a1b77a144b3b",
+}
+
 func TestList(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -100,3 +112,101 @@ func TestDelete(t *testing.T) {
 	err := volumeattach.Delete(client.ServiceClient(), serverID, aID).ExtractErr()
 	th.AssertNoErr(t, err)
 }
+
+func TestCreateTagged(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateTaggedSuccessfully(t)
+
+	serverID := "4d8c3732-a248-40ed-bebc-539a6ffd25c0"
+
+	actual, err := volumeattach.Create(client.ServiceClient(), serverID, volumeattach.CreateOpts{
+		VolumeID:            "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+		Tag:                 "data",
+		DeleteOnTermination: true,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &TaggedVolumeAttachment, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleUpdateSuccessfully(t)
+
+	aID := "a26887c6-c47b-4654-abb5-dfadf7d3f804"
+	serverID := "4d8c3732-a248-40ed-bebc-539a6ffd25c0"
+	deleteOnTermination := false
+
+	err := volumeattach.Update(client.ServiceClient(), serverID, aID, volumeattach.UpdateOpts{
+		VolumeID:            "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+		DeleteOnTermination: &deleteOnTermination,
+	}).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestUpdateRequiresVolumeID(t *testing.T) {
+	err := volumeattach.Update(client.ServiceClient(), "server", "attachment", volumeattach.UpdateOpts{}).ExtractErr()
+	if err == nil {
+		t.Fatal("expected an error for a missing volume ID")
+	}
+}
+
+func TestGetDetails(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetTaggedSuccessfully(t)
+	HandleVolumeGetSuccessfully(t, VolumeInUseOutput)
+
+	serverID := "4d8c3732-a248-40ed-bebc-539a6ffd25c0"
+	volumeID := "a26887c6-c47b-4654-abb5-dfadf7d3f805"
+
+	actual, err := volumeattach.GetDetails(client.ServiceClient(), client.ServiceClient(), serverID, volumeID)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, TaggedVolumeAttachment, actual.Attachment)
+	th.AssertEquals(t, "in-use", actual.VolumeStatus)
+	th.AssertEquals(t, true, actual.Multiattach)
+	th.CheckDeepEquals(t, []string{"1a1f5a2e-7d5e-4b8c-9f11-ccbdb2a3b6d1", serverID}, actual.ServerIDs)
+}
+
+func TestAttachAndWait(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateTaggedSuccessfully(t)
+	HandleGetTaggedSuccessfully(t)
+	HandleVolumeGetSuccessfully(t, VolumeInUseOutput)
+
+	serverID := "4d8c3732-a248-40ed-bebc-539a6ffd25c0"
+
+	actual, err := volumeattach.AttachAndWait(client.ServiceClient(), client.ServiceClient(), serverID, volumeattach.CreateOpts{
+		VolumeID:            "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+		Tag:                 "data",
+		DeleteOnTermination: true,
+	}, 5)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, TaggedVolumeAttachment, actual.Attachment)
+	th.AssertEquals(t, 2, len(actual.ServerIDs))
+}
+
+func TestAttachAndWaitVolumeError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateTaggedSuccessfully(t)
+	HandleVolumeGetSuccessfully(t, VolumeErrorOutput)
+
+	serverID := "4d8c3732-a248-40ed-bebc-539a6ffd25c0"
+
+	_, err := volumeattach.AttachAndWait(client.ServiceClient(), client.ServiceClient(), serverID, volumeattach.CreateOpts{
+		VolumeID:            "a26887c6-c47b-4654-abb5-dfadf7d3f805",
+		Tag:                 "data",
+		DeleteOnTermination: true,
+	}, 5)
+	if _, ok := err.(volumeattach.ErrAttachFailed); !ok {
+		t.Fatalf("expected ErrAttachFailed, got %v", err)
+	}
+}
@@ -23,3 +23,7 @@ func getURL(c *gophercloud.ServiceClient, serverID, aID string) string {
 func deleteURL(c *gophercloud.ServiceClient, serverID, aID string) string {
 	return getURL(c, serverID, aID)
 }
+
+func updateURL(c *gophercloud.ServiceClient, serverID, volumeID string) string {
+	return getURL(c, serverID, volumeID)
+}
@@ -0,0 +1,111 @@
+package volumeattach
+
+import (
+	"strings"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/blockstorage/v3/volumes"
+)
+
+// AttachmentDetails combines the Compute view of a volume attachment with
+// the Block Storage view of the attached volume.
+type AttachmentDetails struct {
+	// Attachment is the attachment as reported by the Compute service.
+	Attachment VolumeAttachment
+
+	// VolumeStatus is the status of the volume in the Block Storage service.
+	VolumeStatus string
+
+	// Multiattach indicates whether the volume can be attached to several
+	// servers at the same time.
+	Multiattach bool
+
+	// ServerIDs are the IDs of all servers the volume is attached to,
+	// including the server of this attachment.
+	ServerIDs []string
+}
+
+// GetDetails retrieves the attachment of the given volume on the given server
+// and the state of the volume in the Block Storage service.
+func GetDetails(computeClient, volumeClient *gophercloud.ServiceClient, serverID, volumeID string) (*AttachmentDetails, error) {
+	attachment, err := Get(computeClient, serverID, volumeID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	volume, err := volumes.Get(volumeClient, volumeID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return newAttachmentDetails(*attachment, volume), nil
+}
+
+func newAttachmentDetails(attachment VolumeAttachment, volume *volumes.Volume) *AttachmentDetails {
+	details := &AttachmentDetails{
+		Attachment:   attachment,
+		VolumeStatus: volume.Status,
+		Multiattach:  volume.Multiattach,
+	}
+	for _, a := range volume.Attachments {
+		details.ServerIDs = append(details.ServerIDs, a.ServerID)
+	}
+	return details
+}
+
+// attachedTo reports whether the Block Storage service lists the volume as
+// attached to the given server. A multiattach volume is already in-use when
+// it is attached to other servers, so the status alone is not enough.
+func attachedTo(volume *volumes.Volume, serverID string) bool {
+	if volume.Status != "in-use" {
+		return false
+	}
+	for _, a := range volume.Attachments {
+		if a.ServerID == serverID {
+			return true
+		}
+	}
+	return false
+}
+
+// AttachAndWait attaches a volume to a server and waits up to secs seconds
+// until both the Compute service reports the attachment and the Block
+// Storage service lists the volume as in-use by the server.
+func AttachAndWait(computeClient, volumeClient *gophercloud.ServiceClient, serverID string, opts CreateOpts, secs int) (*AttachmentDetails, error) {
+	attachment, err := Create(computeClient, serverID, opts).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	var details *AttachmentDetails
+	err = gophercloud.WaitFor(secs, func() (bool, error) {
+		volume, err := volumes.Get(volumeClient, opts.VolumeID).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if strings.HasPrefix(volume.Status, "error") {
+			return false, ErrAttachFailed{ServerID: serverID, VolumeID: opts.VolumeID, Status: volume.Status}
+		}
+
+		if !attachedTo(volume, serverID) {
+			return false, nil
+		}
+
+		current, err := Get(computeClient, serverID, attachment.ID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return false, nil
+			}
+			return false, err
+		}
+
+		details = newAttachmentDetails(*current, volume)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
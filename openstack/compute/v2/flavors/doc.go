@@ -42,6 +42,21 @@ Example to Create a Flavor
 		panic(err)
 	}
 
+Example to Update a Flavor Description
+
+	computeClient.Microversion = "2.55"
+
+	flavorID := "e91758d6-a54a-4778-ad72-0c73a1cb695b"
+
+	updateOpts := flavors.UpdateOpts{
+		Description: "General purpose flavor",
+	}
+
+	flavor, err := flavors.Update(computeClient, flavorID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to List Flavor Access
 
 	flavorID := "e91758d6-a54a-4778-ad72-0c73a1cb695b"
@@ -101,6 +116,23 @@ Example to Create Extra Specs for a Flavor
 
 	fmt.Printf("%+v", createdExtraSpecs)
 
+Example to Validate Extra Specs Before Creating Them
+
+	flavorID := "e91758d6-a54a-4778-ad72-0c73a1cb695b"
+
+	createOpts := flavors.ValidatedExtraSpecsOpts{
+		"hw:cpu_policy":         "dedicated",
+		"hw:numa_nodes":         "2",
+		"resources:CUSTOM_FPGA": "1",
+		"trait:HW_CPU_X86_AVX2": "required",
+	}
+	createdExtraSpecs, err := flavors.CreateExtraSpecs(computeClient, flavorID, createOpts).Extract()
+	if err, ok := err.(flavors.ErrInvalidExtraSpecs); ok {
+		for _, spec := range err.Invalid {
+			fmt.Printf("%s: %s\n", spec.Key, spec.Reason)
+		}
+	}
+
 Example to Get Extra Specs for a Flavor
 
 	flavorID := "e91758d6-a54a-4778-ad72-0c73a1cb695b"
@@ -0,0 +1,32 @@
+package flavors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// InvalidExtraSpec describes an extra spec rejected by ValidateExtraSpecs.
+type InvalidExtraSpec struct {
+	Key    string
+	Value  string
+	Reason string
+}
+
+// ErrInvalidExtraSpecs is returned by ValidateExtraSpecs when some extra specs
+// are invalid.
+type ErrInvalidExtraSpecs struct {
+	gophercloud.BaseError
+
+	// Invalid are the rejected extra specs, sorted by key.
+	Invalid []InvalidExtraSpec
+}
+
+func (e ErrInvalidExtraSpecs) Error() string {
+	invalid := make([]string, len(e.Invalid))
+	for i, spec := range e.Invalid {
+		invalid[i] = fmt.Sprintf("%s=%q: %s", spec.Key, spec.Value, spec.Reason)
+	}
+	return fmt.Sprintf("Invalid flavor extra specs: %s", strings.Join(invalid, "; "))
+}
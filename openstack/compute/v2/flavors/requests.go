@@ -120,6 +120,11 @@ type CreateOpts struct {
 
 	// Ephemeral is the amount of ephemeral disk space, measured in GB.
 	Ephemeral *int `json:"OS-FLV-EXT-DATA:ephemeral,omitempty"`
+
+	// Description is a free form description of the flavor. Limited to
+	// 65535 characters in length. Only printable characters are allowed.
+	// Requires microversion 2.55 or later.
+	Description string `json:"description,omitempty"`
 }
 
 // ToFlavorCreateMap constructs a request body from CreateOpts.
@@ -140,6 +145,40 @@ func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r Create
 	return
 }
 
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToFlavorUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts specifies parameters used for updating a flavor. Only the
+// description of a flavor can be updated.
+type UpdateOpts struct {
+	// Description is a free form description of the flavor. Limited to
+	// 65535 characters in length. Only printable characters are allowed.
+	// An empty description removes the current one.
+	Description string `json:"description"`
+}
+
+// ToFlavorUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToFlavorUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// Update requests the update of an existing flavor.
+// Requires microversion 2.55 or later.
+func Update(client *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFlavorUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(updateURL(client, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
 // Get retrieves details of a single flavor. Use ExtractFlavor to convert its
 // result into a Flavor.
 func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
@@ -248,6 +287,29 @@ func (opts ExtraSpecsOpts) ToFlavorExtraSpecsCreateMap() (map[string]interface{}
 	return map[string]interface{}{"extra_specs": opts}, nil
 }
 
+// ValidatedExtraSpecsOpts is an ExtraSpecsOpts that is checked with
+// ValidateExtraSpecs before it is sent, so that invalid well-known extra specs
+// are reported without calling the API.
+type ValidatedExtraSpecsOpts map[string]string
+
+// ToFlavorExtraSpecsCreateMap validates the extra specs and assembles a body
+// for a Create request.
+func (opts ValidatedExtraSpecsOpts) ToFlavorExtraSpecsCreateMap() (map[string]interface{}, error) {
+	if err := ValidateExtraSpecs(opts); err != nil {
+		return nil, err
+	}
+	return ExtraSpecsOpts(opts).ToFlavorExtraSpecsCreateMap()
+}
+
+// ToFlavorExtraSpecUpdateMap validates the extra spec and assembles a body
+// for an Update request.
+func (opts ValidatedExtraSpecsOpts) ToFlavorExtraSpecUpdateMap() (map[string]string, string, error) {
+	if err := ValidateExtraSpecs(opts); err != nil {
+		return nil, "", err
+	}
+	return ExtraSpecsOpts(opts).ToFlavorExtraSpecUpdateMap()
+}
+
 // CreateExtraSpecs will create or update the extra-specs key-value pairs for
 // the specified Flavor.
 func CreateExtraSpecs(client *gophercloud.ServiceClient, flavorID string, opts CreateExtraSpecsOptsBuilder) (r CreateExtraSpecsResult) {
@@ -24,6 +24,12 @@ type GetResult struct {
 	commonResult
 }
 
+// UpdateResult is the response of an Update operation. Call its Extract
+// method to interpret it as a Flavor.
+type UpdateResult struct {
+	commonResult
+}
+
 // DeleteResult is the result from a Delete operation. Call its ExtractErr
 // method to determine if the call succeeded or failed.
 type DeleteResult struct {
@@ -69,6 +75,10 @@ type Flavor struct {
 
 	// Ephemeral is the amount of ephemeral disk space, measured in GB.
 	Ephemeral int `json:"OS-FLV-EXT-DATA:ephemeral"`
+
+	// Description is a free form description of the flavor.
+	// Requires microversion 2.55 or later.
+	Description string `json:"description"`
 }
 
 func (r *Flavor) UnmarshalJSON(b []byte) error {
@@ -400,3 +400,148 @@ func TestFlavorExtraSpecDelete(t *testing.T) {
 	res := flavors.DeleteExtraSpec(fake.ServiceClient(), "1", "hw:cpu_policy")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestUpdateFlavor(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/12345678", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `{"flavor": {"description": "foo"}}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprint(w, `
+			{
+				"flavor": {
+					"id": "12345678",
+					"name": "m1.tiny",
+					"disk": 1,
+					"ram": 512,
+					"vcpus": 1,
+					"rxtx_factor": 1,
+					"swap": "",
+					"description": "foo"
+				}
+			}
+		`)
+	})
+
+	actual, err := flavors.Update(fake.ServiceClient(), "12345678", flavors.UpdateOpts{
+		Description: "foo",
+	}).Extract()
+	th.AssertNoErr(t, err)
+
+	expected := &flavors.Flavor{
+		ID:          "12345678",
+		Name:        "m1.tiny",
+		Disk:        1,
+		RAM:         512,
+		VCPUs:       1,
+		RxTxFactor:  1,
+		Description: "foo",
+	}
+	th.CheckDeepEquals(t, expected, actual)
+}
+
+func TestValidateExtraSpecs(t *testing.T) {
+	valid := map[string]string{
+		"hw:cpu_policy":                    "dedicated",
+		"hw:cpu_thread_policy":             "isolate",
+		"hw:numa_nodes":                    "2",
+		"hw:numa_cpus.0":                   "0-3,^2",
+		"hw:numa_mem.1":                    "2048",
+		"hw:mem_page_size":                 "2MB",
+		"hw:cpu_realtime":                  "yes",
+		"hw_rng:rate_bytes":                "1024",
+		"quota:disk_read_iops_sec":         "1000",
+		"resources:VCPU":                   "0",
+		"resources1:CUSTOM_FPGA":           "1",
+		"trait:HW_CPU_X86_AVX2":            "required",
+		"trait_storage:CUSTOM_SSD":         "forbidden",
+		"group_policy":                     "isolate",
+		"pci_passthrough:alias":            "a1:2,a2:1",
+		"aggregate_instance_extra_specs:x": "anything",
+		"vendor:custom":                    "anything",
+	}
+	th.AssertNoErr(t, flavors.ValidateExtraSpecs(valid))
+
+	invalid := map[string]string{
+		"hw:cpu_policy":         "CPU-POLICY",
+		"hw:numa_nodes":         "0",
+		"hw:numa_cpus.x":        "0-1",
+		"resources:vcpu":        "1",
+		"resources:MEMORY_MB":   "-1",
+		"trait:HW_CPU_X86_AVX2": "yes",
+		"quota:cpu_shares":      "many",
+	}
+	err := flavors.ValidateExtraSpecs(invalid)
+	actual, ok := err.(flavors.ErrInvalidExtraSpecs)
+	if !ok {
+		t.Fatalf("expected ErrInvalidExtraSpecs, got %v", err)
+	}
+
+	keys := make([]string, len(actual.Invalid))
+	for i, spec := range actual.Invalid {
+		keys[i] = spec.Key
+	}
+	th.CheckDeepEquals(t, []string{
+		"hw:cpu_policy",
+		"hw:numa_cpus.x",
+		"hw:numa_nodes",
+		"quota:cpu_shares",
+		"resources:MEMORY_MB",
+		"resources:vcpu",
+		"trait:HW_CPU_X86_AVX2",
+	}, keys)
+	th.AssertEquals(t, "must be one of shared, dedicated, mixed", actual.Invalid[0].Reason)
+}
+
+func TestValidateExtraSpecsMemPageSize(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{"small", true},
+		{"large", true},
+		{"any", true},
+		{"4096", true},
+		{"2MB", true},
+		{"2MiB", true},
+		{"1GiB", true},
+		{"2048KiB", true},
+		{"1T", true},
+		{"1Gbit", true},
+		{"2m", false},
+		{"2mb", false},
+		{"huge", false},
+		{"", false},
+	}
+
+	for _, c := range cases {
+		err := flavors.ValidateExtraSpecs(map[string]string{"hw:mem_page_size": c.value})
+		if c.valid && err != nil {
+			t.Errorf("expected %q to be valid, got %v", c.value, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("expected %q to be invalid", c.value)
+		}
+	}
+}
+
+func TestFlavorValidatedExtraSpecsCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/flavors/1/os-extra_specs", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("Invalid extra specs must not be sent")
+	})
+
+	createOpts := flavors.ValidatedExtraSpecsOpts{
+		"hw:cpu_policy": "CPU-POLICY",
+	}
+	_, err := flavors.CreateExtraSpecs(fake.ServiceClient(), "1", createOpts).Extract()
+	if _, ok := err.(flavors.ErrInvalidExtraSpecs); !ok {
+		t.Fatalf("expected ErrInvalidExtraSpecs, got %v", err)
+	}
+}
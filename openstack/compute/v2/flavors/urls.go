@@ -16,6 +16,10 @@ func createURL(client *gophercloud.ServiceClient) string {
 	return client.ServiceURL("flavors")
 }
 
+func updateURL(client *gophercloud.ServiceClient, id string) string {
+	return client.ServiceURL("flavors", id)
+}
+
 func deleteURL(client *gophercloud.ServiceClient, id string) string {
 	return client.ServiceURL("flavors", id)
 }
@@ -0,0 +1,154 @@
+package flavors
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// extraSpecValidator checks the value of an extra spec and returns the reason
+// it is invalid, or an empty string if it is valid.
+type extraSpecValidator func(value string) string
+
+func oneOf(values ...string) extraSpecValidator {
+	return func(value string) string {
+		for _, v := range values {
+			if value == v {
+				return ""
+			}
+		}
+		return fmt.Sprintf("must be one of %s", strings.Join(values, ", "))
+	}
+}
+
+func intAtLeast(min int) extraSpecValidator {
+	return func(value string) string {
+		i, err := strconv.Atoi(value)
+		if err != nil || i < min {
+			return fmt.Sprintf("must be an integer greater than or equal to %d", min)
+		}
+		return ""
+	}
+}
+
+func boolean(value string) string {
+	switch strings.ToLower(value) {
+	case "true", "false", "yes", "no", "on", "off", "1", "0", "t", "f", "y", "n":
+		return ""
+	}
+	return "must be a boolean"
+}
+
+func matches(re *regexp.Regexp, format string) extraSpecValidator {
+	return func(value string) string {
+		if !re.MatchString(value) {
+			return fmt.Sprintf("must be %s", format)
+		}
+		return ""
+	}
+}
+
+var (
+	cpuSetRe       = regexp.MustCompile(`^\^?\d+(-\d+)?(,\^?\d+(-\d+)?)*$`)
+	pageSizeRe     = regexp.MustCompile(`^(large|small|any|\d+([kKMGT]i?)?(b|bit|B)?)$`)
+	pciAliasRe     = regexp.MustCompile(`^[^:,]+:\d+(,[^:,]+:\d+)*$`)
+	numaSpecRe     = regexp.MustCompile(`^hw:numa_(cpus|mem)\.(.*)$`)
+	groupedSpecRe  = regexp.MustCompile(`^(resources|trait)([1-9][0-9]*|_[a-zA-Z0-9_-]{1,64})?:(.*)$`)
+	resourceNameRe = regexp.MustCompile(`^[A-Z0-9_]+$`)
+	digitsRe       = regexp.MustCompile(`^\d+$`)
+
+	cpuSet   = matches(cpuSetRe, "a CPU set such as 0-3,^2,5")
+	pageSize = matches(pageSizeRe, "small, large, any or a page size such as 2MB")
+)
+
+// extraSpecValidators are the validators of the well-known extra specs.
+var extraSpecValidators = map[string]extraSpecValidator{
+	"hw:cpu_policy":              oneOf("shared", "dedicated", "mixed"),
+	"hw:cpu_thread_policy":       oneOf("prefer", "isolate", "require"),
+	"hw:emulator_threads_policy": oneOf("share", "isolate"),
+	"hw:numa_nodes":              intAtLeast(1),
+	"hw:mem_page_size":           pageSize,
+	"hw:cpu_sockets":             intAtLeast(1),
+	"hw:cpu_cores":               intAtLeast(1),
+	"hw:cpu_threads":             intAtLeast(1),
+	"hw:cpu_max_sockets":         intAtLeast(1),
+	"hw:cpu_max_cores":           intAtLeast(1),
+	"hw:cpu_max_threads":         intAtLeast(1),
+	"hw:cpu_realtime":            boolean,
+	"hw:cpu_realtime_mask":       cpuSet,
+	"hw:cpu_dedicated_mask":      cpuSet,
+	"hw:watchdog_action":         oneOf("none", "pause", "poweroff", "reset", "disabled"),
+	"hw:mem_encryption":          boolean,
+	"hw:boot_menu":               boolean,
+	"hw:vif_multiqueue_enabled":  boolean,
+	"hw:pmu":                     boolean,
+	"hw:serial_port_count":       intAtLeast(0),
+	"hw_rng:allowed":             boolean,
+	"hw_rng:rate_bytes":          intAtLeast(0),
+	"hw_rng:rate_period":         intAtLeast(0),
+	"group_policy":               oneOf("none", "isolate"),
+	"os:secure_boot":             oneOf("required", "disabled"),
+	"pci_passthrough:alias":      matches(pciAliasRe, "a list of alias:count pairs"),
+}
+
+// validateExtraSpec returns the reason an extra spec is invalid, or an empty
+// string if it is valid or not well-known.
+func validateExtraSpec(key, value string) string {
+	if validator, ok := extraSpecValidators[key]; ok {
+		return validator(value)
+	}
+
+	if m := numaSpecRe.FindStringSubmatch(key); m != nil {
+		if !digitsRe.MatchString(m[2]) {
+			return "NUMA node must be a non-negative integer"
+		}
+		if m[1] == "cpus" {
+			return cpuSet(value)
+		}
+		return intAtLeast(0)(value)
+	}
+
+	if m := groupedSpecRe.FindStringSubmatch(key); m != nil {
+		name := m[3]
+		if m[1] == "resources" {
+			if !resourceNameRe.MatchString(name) {
+				return "resource class must be a standard class or start with CUSTOM_ and contain only A-Z, 0-9 and _"
+			}
+			return intAtLeast(0)(value)
+		}
+		if !resourceNameRe.MatchString(name) {
+			return "trait must be a standard trait or start with CUSTOM_ and contain only A-Z, 0-9 and _"
+		}
+		return oneOf("required", "forbidden")(value)
+	}
+
+	if strings.HasPrefix(key, "quota:") {
+		return intAtLeast(0)(value)
+	}
+
+	return ""
+}
+
+// ValidateExtraSpecs checks the well-known flavor extra specs, such as the hw:,
+// hw_rng:, quota:, resources: and trait: namespaces, and returns an
+// ErrInvalidExtraSpecs listing the invalid ones. Extra specs outside of these
+// namespaces, such as those used by aggregate filters, are not checked.
+func ValidateExtraSpecs(specs map[string]string) error {
+	var invalid []InvalidExtraSpec
+	for key, value := range specs {
+		if reason := validateExtraSpec(key, value); reason != "" {
+			invalid = append(invalid, InvalidExtraSpec{Key: key, Value: value, Reason: reason})
+		}
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	sort.Slice(invalid, func(i, j int) bool {
+		return invalid[i].Key < invalid[j].Key
+	})
+	return ErrInvalidExtraSpecs{Invalid: invalid}
+}
@@ -38,6 +38,27 @@ Example to Create a Server
 		panic(err)
 	}
 
+Example to Create Multiple Servers and Wait for Them
+
+	createOpts := servers.CreateOpts{
+		Name:                "worker",
+		ImageRef:            "image-uuid",
+		FlavorId:            "flavor-uuid",
+		MinCount:            100,
+		MaxCount:            100,
+		ReturnReservationID: true,
+	}
+
+	reservationID, err := servers.Create(computeClient, createOpts).ExtractReservationID()
+	if err != nil {
+		panic(err)
+	}
+
+	workers, err := servers.WaitForReservation(computeClient, reservationID, 100, 1800)
+	if err != nil {
+		panic(err)
+	}
+
 Example to Delete a Server
 
 	serverID := "d9072956-1560-487c-97f2-18bdf65ec749"
@@ -69,3 +69,16 @@ type ErrServerNotFound struct {
 func (e ErrServerNotFound) Error() string {
 	return fmt.Sprintf("I couldn't find server [%s]", e.ID)
 }
+
+// ErrReservationServerInError is the error when a server launched by a
+// multiple create request goes to the ERROR status.
+type ErrReservationServerInError struct {
+	gophercloud.BaseError
+	ReservationID string
+	ServerID      string
+	Fault         Fault
+}
+
+func (e ErrReservationServerInError) Error() string {
+	return fmt.Sprintf("Server [%s] of reservation [%s] is in ERROR: %s", e.ServerID, e.ReservationID, e.Fault.Message)
+}
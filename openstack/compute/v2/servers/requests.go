@@ -243,6 +243,19 @@ type CreateOpts struct {
 	// server.
 	Metadata map[string]string `json:"metadata,omitempty"`
 
+	// MinCount is the minimum number of servers to launch. The request fails
+	// if the quota does not allow for at least this many servers.
+	MinCount int `json:"min_count,omitempty"`
+
+	// MaxCount is the maximum number of servers to launch. As many servers as
+	// the quota allows, up to MaxCount, are launched.
+	MaxCount int `json:"max_count,omitempty"`
+
+	// ReturnReservationID makes Create return the reservation ID of the
+	// request instead of the first created server. Use
+	// CreateResult.ExtractReservationID to read it.
+	ReturnReservationID bool `json:"return_reservation_id,omitempty"`
+
 	// ServiceClient will allow calls to be made to retrieve an image or
 	// flavor ID by name.
 	ServiceClient *gophercloud.ServiceClient `json:"-"`
@@ -33,11 +33,22 @@ func ExtractServersInto(r pagination.Page, v interface{}) error {
 }
 
 // CreateResult is the response from a Create operation. Call its Extract
-// method to interpret it as a Server.
+// method to interpret it as a Server, or its ExtractReservationID method if
+// the server was created with ReturnReservationID set.
 type CreateResult struct {
 	serverResult
 }
 
+// ExtractReservationID interprets a CreateResult as the reservation ID of a
+// request that launched one or more servers.
+func (r CreateResult) ExtractReservationID() (string, error) {
+	var s struct {
+		ReservationID string `json:"reservation_id"`
+	}
+	err := r.Result.ExtractInto(&s)
+	return s.ReservationID, err
+}
+
 // GetResult is the response from a Get operation. Call its Extract
 // method to interpret it as a Server.
 type GetResult struct {
@@ -256,6 +267,9 @@ type Server struct {
 
 	// 云服务器项目 id
 	TenantId  string `json:"tenant_id"`
+
+	// Fault contains the reason a server went to the ERROR status.
+	Fault Fault `json:"fault"`
 }
 
 type volume_attached struct{
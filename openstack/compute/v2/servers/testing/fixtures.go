@@ -396,9 +396,17 @@ var (
 	herpTimeUpdated, _ = time.Parse(time.RFC3339, "2014-09-25T13:10:10Z")
 	// ServerHerp is a Server struct that should correspond to the first result in ServerListBody.
 	ServerHerp = servers.Server{
-		Status:  "ACTIVE",
-		Updated: herpTimeUpdated,
-		HostID:  "29d3c8c896a45aa4c34e52247875d7fefc3d94bbcc9f622b5d204362",
+		OS_EXT_STS_vm_state:                 "active",
+		OS_EXT_STS_power_state:              1,
+		OS_EXT_SRV_ATTR_instance_name:       "instance-0000001e",
+		OS_EXT_SRV_ATTR_hypervisor_hostname: "devstack",
+		OS_EXT_SRV_ATTR_host:                "devstack",
+		OS_EXT_AZ_availability_zone:         "nova",
+		OS_DCF_diskConfig:                   "MANUAL",
+		OS_SRV_USG_launched_at:              "2014-09-25T13:10:10.000000",
+		Status:                              "ACTIVE",
+		Updated:                             herpTimeUpdated,
+		HostID:                              "29d3c8c896a45aa4c34e52247875d7fefc3d94bbcc9f622b5d204362",
 		Addresses: map[string]interface{}{
 			"private": []interface{}{
 				map[string]interface{}{
@@ -409,16 +417,6 @@ var (
 				},
 			},
 		},
-		Links: []interface{}{
-			map[string]interface{}{
-				"href": "http://104.130.131.164:8774/v2/fcad67a6189847c4aecfa3c81a05783b/servers/ef079b0c-e610-4dfb-b1aa-b49f07ac48e5",
-				"rel":  "self",
-			},
-			map[string]interface{}{
-				"href": "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/servers/ef079b0c-e610-4dfb-b1aa-b49f07ac48e5",
-				"rel":  "bookmark",
-			},
-		},
 		Image: map[string]interface{}{
 			"id": "f90f6034-2570-4974-8351-6b49732ef2eb",
 			"links": []interface{}{
@@ -441,7 +439,7 @@ var (
 		UserID:   "9349aff8be7545ac9d2f1d00999a23cd",
 		Name:     "herp",
 		Created:  herpTimeCreated,
-		TenantID: "fcad67a6189847c4aecfa3c81a05783b",
+		TenantId: "fcad67a6189847c4aecfa3c81a05783b",
 		Metadata: map[string]string{},
 		SecurityGroups: []map[string]interface{}{
 			map[string]interface{}{
@@ -454,9 +452,17 @@ var (
 	derpTimeUpdated, _ = time.Parse(time.RFC3339, "2014-09-25T13:04:49Z")
 	// ServerDerp is a Server struct that should correspond to the second server in ServerListBody.
 	ServerDerp = servers.Server{
-		Status:  "ACTIVE",
-		Updated: derpTimeUpdated,
-		HostID:  "29d3c8c896a45aa4c34e52247875d7fefc3d94bbcc9f622b5d204362",
+		OS_EXT_STS_vm_state:                 "active",
+		OS_EXT_STS_power_state:              1,
+		OS_EXT_SRV_ATTR_instance_name:       "instance-0000001d",
+		OS_EXT_SRV_ATTR_hypervisor_hostname: "devstack",
+		OS_EXT_SRV_ATTR_host:                "devstack",
+		OS_EXT_AZ_availability_zone:         "nova",
+		OS_DCF_diskConfig:                   "MANUAL",
+		OS_SRV_USG_launched_at:              "2014-09-25T13:04:49.000000",
+		Status:                              "ACTIVE",
+		Updated:                             derpTimeUpdated,
+		HostID:                              "29d3c8c896a45aa4c34e52247875d7fefc3d94bbcc9f622b5d204362",
 		Addresses: map[string]interface{}{
 			"private": []interface{}{
 				map[string]interface{}{
@@ -467,16 +473,6 @@ var (
 				},
 			},
 		},
-		Links: []interface{}{
-			map[string]interface{}{
-				"href": "http://104.130.131.164:8774/v2/fcad67a6189847c4aecfa3c81a05783b/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba",
-				"rel":  "self",
-			},
-			map[string]interface{}{
-				"href": "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba",
-				"rel":  "bookmark",
-			},
-		},
 		Image: map[string]interface{}{
 			"id": "f90f6034-2570-4974-8351-6b49732ef2eb",
 			"links": []interface{}{
@@ -499,7 +495,7 @@ var (
 		UserID:   "9349aff8be7545ac9d2f1d00999a23cd",
 		Name:     "derp",
 		Created:  derpTimeCreated,
-		TenantID: "fcad67a6189847c4aecfa3c81a05783b",
+		TenantId: "fcad67a6189847c4aecfa3c81a05783b",
 		Metadata: map[string]string{},
 		SecurityGroups: []map[string]interface{}{
 			map[string]interface{}{
@@ -514,9 +510,17 @@ var (
 	merpTimeUpdated, _ = time.Parse(time.RFC3339, "2014-09-25T13:04:49Z")
 	// ServerMerp is a Server struct that should correspond to the second server in ServerListBody.
 	ServerMerp = servers.Server{
-		Status:  "ACTIVE",
-		Updated: merpTimeUpdated,
-		HostID:  "29d3c8c896a45aa4c34e52247875d7fefc3d94bbcc9f622b5d204362",
+		OS_EXT_STS_vm_state:                 "active",
+		OS_EXT_STS_power_state:              1,
+		OS_EXT_SRV_ATTR_instance_name:       "instance-0000001d",
+		OS_EXT_SRV_ATTR_hypervisor_hostname: "devstack",
+		OS_EXT_SRV_ATTR_host:                "devstack",
+		OS_EXT_AZ_availability_zone:         "nova",
+		OS_DCF_diskConfig:                   "MANUAL",
+		OS_SRV_USG_launched_at:              "2014-09-25T13:04:49.000000",
+		Status:                              "ACTIVE",
+		Updated:                             merpTimeUpdated,
+		HostID:                              "29d3c8c896a45aa4c34e52247875d7fefc3d94bbcc9f622b5d204362",
 		Addresses: map[string]interface{}{
 			"private": []interface{}{
 				map[string]interface{}{
@@ -527,16 +531,6 @@ var (
 				},
 			},
 		},
-		Links: []interface{}{
-			map[string]interface{}{
-				"href": "http://104.130.131.164:8774/v2/fcad67a6189847c4aecfa3c81a05783b/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba",
-				"rel":  "self",
-			},
-			map[string]interface{}{
-				"href": "http://104.130.131.164:8774/fcad67a6189847c4aecfa3c81a05783b/servers/9e5476bd-a4ec-4653-93d6-72c93aa682ba",
-				"rel":  "bookmark",
-			},
-		},
 		Image: nil,
 		Flavor: map[string]interface{}{
 			"id": "1",
@@ -551,7 +545,7 @@ var (
 		UserID:   "9349aff8be7545ac9d2f1d00999a23cd",
 		Name:     "merp",
 		Created:  merpTimeCreated,
-		TenantID: "fcad67a6189847c4aecfa3c81a05783b",
+		TenantId: "fcad67a6189847c4aecfa3c81a05783b",
 		Metadata: map[string]string{},
 		SecurityGroups: []map[string]interface{}{
 			map[string]interface{}{
@@ -582,16 +576,37 @@ func (opts CreateOptsWithCustomField) ToServerCreateMap() (map[string]interface{
 // HandleServerCreationSuccessfully sets up the test server to respond to a server creation request
 // with a given response.
 func HandleServerCreationSuccessfully(t *testing.T, response string) {
+	handleServerCreation(t, `{
+		"server": {
+			"name": "derp",
+			"bss_args": {"period": ""},
+			"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+			"required_hosts": "",
+			"flavorRef": "1",
+			"flavor_id": "1"
+		}
+	}`, response)
+}
+
+// HandleServerCreationByNameSuccessfully sets up the test server to respond to
+// a server creation request which looks up the image and flavor by name.
+func HandleServerCreationByNameSuccessfully(t *testing.T, response string) {
+	handleServerCreation(t, `{
+		"server": {
+			"name": "derp",
+			"bss_args": {"period": ""},
+			"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+			"required_hosts": "",
+			"flavorRef": "1"
+		}
+	}`, response)
+}
+
+func handleServerCreation(t *testing.T, request, response string) {
 	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
 		th.TestMethod(t, r, "POST")
 		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
-		th.TestJSONRequest(t, r, `{
-			"server": {
-				"name": "derp",
-				"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
-				"flavorRef": "1"
-			}
-		}`)
+		th.TestJSONRequest(t, r, request)
 
 		w.WriteHeader(http.StatusAccepted)
 		w.Header().Add("Content-Type", "application/json")
@@ -697,7 +712,9 @@ func HandleServerCreationWithCustomFieldSuccessfully(t *testing.T, response stri
 			"server": {
 				"name": "derp",
 				"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
-				"flavorRef": "1",
+				"bss_args": {"period": ""},
+				"required_hosts": "",
+				"flavor_id": "1",
 				"foo": "bar"
 			}
 		}`)
@@ -718,6 +735,9 @@ func HandleServerCreationWithUserdata(t *testing.T, response string) {
 			"server": {
 				"name": "derp",
 				"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+				"bss_args": {"period": ""},
+				"required_hosts": "",
+				"flavor_id": "1",
 				"flavorRef": "1",
 				"user_data": "dXNlcmRhdGEgc3RyaW5n"
 			}
@@ -739,6 +759,9 @@ func HandleServerCreationWithMetadata(t *testing.T, response string) {
 			"server": {
 				"name": "derp",
 				"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+				"bss_args": {"period": ""},
+				"required_hosts": "",
+				"flavor_id": "1",
 				"flavorRef": "1",
 				"metadata": {
 					"abc": "def"
@@ -1092,3 +1115,71 @@ func HandlePasswordGetSuccessfully(t *testing.T) {
 		fmt.Fprintf(w, ServerPasswordBody)
 	})
 }
+
+// ReservationID is the reservation ID of a multiple create request.
+const ReservationID = "r-3fhpjulh"
+
+// ReservationServerListBody contains the servers launched by a multiple
+// create request.
+const ReservationServerListBody = `
+{
+	"servers": [
+		{
+			"id": "9e5476bd-a4ec-4653-93d6-72c93aa682ba",
+			"name": "worker-1",
+			"status": "ACTIVE",
+			"tenant_id": "fcad67a6189847c4aecfa3c81a05783b"
+		},
+		{
+			"id": "ef079b0c-e610-4dfb-b1aa-b49f07ac48e5",
+			"name": "worker-2",
+			"status": "%s",
+			"tenant_id": "fcad67a6189847c4aecfa3c81a05783b",
+			"fault": {
+				"message": "No valid host was found.",
+				"code": 500,
+				"created": "2017-11-11T07:58:39Z"
+			}
+		}
+	]
+}
+`
+
+// HandleServerCreationWithReservationSuccessfully sets up the test server to
+// respond to a multiple create request returning a reservation ID.
+func HandleServerCreationWithReservationSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/servers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{
+			"server": {
+				"name": "worker",
+				"bss_args": {"period": ""},
+				"imageRef": "f90f6034-2570-4974-8351-6b49732ef2eb",
+				"required_hosts": "",
+				"flavorRef": "1",
+				"flavor_id": "1",
+				"min_count": 2,
+				"max_count": 2,
+				"return_reservation_id": true
+			}
+		}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, `{"reservation_id": "%s"}`, ReservationID)
+	})
+}
+
+// HandleServerListByReservationSuccessfully sets up the test server to list
+// the servers of a reservation, the second of which has the given status.
+func HandleServerListByReservationSuccessfully(t *testing.T, status string) {
+	th.Mux.HandleFunc("/servers/detail", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{"reservation_id": ReservationID})
+
+		w.Header().Add("Content-Type", "application/json")
+		fmt.Fprintf(w, ReservationServerListBody, status)
+	})
+}
@@ -91,9 +91,9 @@ func TestCreateServer(t *testing.T) {
 	HandleServerCreationSuccessfully(t, SingleServerBody)
 
 	actual, err := servers.Create(client.ServiceClient(), servers.CreateOpts{
-		Name:      "derp",
-		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
-		FlavorRef: "1",
+		Name:     "derp",
+		ImageRef: "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorId: "1",
 	}).Extract()
 	th.AssertNoErr(t, err)
 
@@ -107,9 +107,9 @@ func TestCreateServerWithCustomField(t *testing.T) {
 
 	actual, err := servers.Create(client.ServiceClient(), CreateOptsWithCustomField{
 		CreateOpts: servers.CreateOpts{
-			Name:      "derp",
-			ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
-			FlavorRef: "1",
+			Name:     "derp",
+			ImageRef: "f90f6034-2570-4974-8351-6b49732ef2eb",
+			FlavorId: "1",
 		},
 		Foo: "bar",
 	}).Extract()
@@ -124,9 +124,9 @@ func TestCreateServerWithMetadata(t *testing.T) {
 	HandleServerCreationWithMetadata(t, SingleServerBody)
 
 	actual, err := servers.Create(client.ServiceClient(), servers.CreateOpts{
-		Name:      "derp",
-		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
-		FlavorRef: "1",
+		Name:     "derp",
+		ImageRef: "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorId: "1",
 		Metadata: map[string]string{
 			"abc": "def",
 		},
@@ -142,10 +142,10 @@ func TestCreateServerWithUserdataString(t *testing.T) {
 	HandleServerCreationWithUserdata(t, SingleServerBody)
 
 	actual, err := servers.Create(client.ServiceClient(), servers.CreateOpts{
-		Name:      "derp",
-		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
-		FlavorRef: "1",
-		UserData:  []byte("userdata string"),
+		Name:     "derp",
+		ImageRef: "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorId: "1",
+		UserData: []byte("userdata string"),
 	}).Extract()
 	th.AssertNoErr(t, err)
 
@@ -160,10 +160,10 @@ func TestCreateServerWithUserdataEncoded(t *testing.T) {
 	encoded := base64.StdEncoding.EncodeToString([]byte("userdata string"))
 
 	actual, err := servers.Create(client.ServiceClient(), servers.CreateOpts{
-		Name:      "derp",
-		ImageRef:  "f90f6034-2570-4974-8351-6b49732ef2eb",
-		FlavorRef: "1",
-		UserData:  []byte(encoded),
+		Name:     "derp",
+		ImageRef: "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorId: "1",
+		UserData: []byte(encoded),
 	}).Extract()
 	th.AssertNoErr(t, err)
 
@@ -173,7 +173,7 @@ func TestCreateServerWithUserdataEncoded(t *testing.T) {
 func TestCreateServerWithImageNameAndFlavorName(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
-	HandleServerCreationSuccessfully(t, SingleServerBody)
+	HandleServerCreationByNameSuccessfully(t, SingleServerBody)
 
 	actual, err := servers.Create(client.ServiceClient(), servers.CreateOpts{
 		Name:          "derp",
@@ -564,3 +564,58 @@ func TestMarshalPersonality(t *testing.T) {
 		t.Fatal("file contents incorrect")
 	}
 }
+
+func TestCreateServersWithReservation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerCreationWithReservationSuccessfully(t)
+
+	reservationID, err := servers.Create(client.ServiceClient(), servers.CreateOpts{
+		Name:                "worker",
+		ImageRef:            "f90f6034-2570-4974-8351-6b49732ef2eb",
+		FlavorId:            "1",
+		MinCount:            2,
+		MaxCount:            2,
+		ReturnReservationID: true,
+	}).ExtractReservationID()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, ReservationID, reservationID)
+}
+
+func TestListByReservation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerListByReservationSuccessfully(t, "BUILD")
+
+	members, err := servers.ListByReservation(client.ServiceClient(), ReservationID)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(members))
+	th.AssertEquals(t, "9e5476bd-a4ec-4653-93d6-72c93aa682ba", members[0].ID)
+	th.AssertEquals(t, "ACTIVE", members[0].Status)
+	th.AssertEquals(t, "ef079b0c-e610-4dfb-b1aa-b49f07ac48e5", members[1].ID)
+	th.AssertEquals(t, "BUILD", members[1].Status)
+}
+
+func TestWaitForReservation(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerListByReservationSuccessfully(t, "ACTIVE")
+
+	members, err := servers.WaitForReservation(client.ServiceClient(), ReservationID, 2, 5)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(members))
+}
+
+func TestWaitForReservationError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+	HandleServerListByReservationSuccessfully(t, "ERROR")
+
+	_, err := servers.WaitForReservation(client.ServiceClient(), ReservationID, 2, 5)
+	serverErr, ok := err.(servers.ErrReservationServerInError)
+	if !ok {
+		t.Fatalf("Expected ErrReservationServerInError, got %v", err)
+	}
+	th.AssertEquals(t, "ef079b0c-e610-4dfb-b1aa-b49f07ac48e5", serverErr.ServerID)
+	th.AssertEquals(t, "No valid host was found.", serverErr.Fault.Message)
+}
@@ -19,3 +19,54 @@ func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) er
 		return false, nil
 	})
 }
+
+// ListByReservation returns all the servers launched by a single Create
+// request, identified by the reservation ID of the request.
+func ListByReservation(c *gophercloud.ServiceClient, reservationID string) ([]Server, error) {
+	allPages, err := List(c, ListOpts{ReservationId: reservationID}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	return ExtractServers(allPages)
+}
+
+// WaitForReservation will continually poll the servers launched by a single
+// Create request until at least count of them exist and all of them are
+// ACTIVE. It returns an ErrReservationServerInError as soon as any of them
+// goes to ERROR. It will do this for at most the number of seconds
+// specified.
+func WaitForReservation(c *gophercloud.ServiceClient, reservationID string, count, secs int) ([]Server, error) {
+	var members []Server
+	err := gophercloud.WaitFor(secs, func() (bool, error) {
+		current, err := ListByReservation(c, reservationID)
+		if err != nil {
+			return false, err
+		}
+
+		for _, server := range current {
+			if server.Status == "ERROR" {
+				return false, ErrReservationServerInError{
+					ReservationID: reservationID,
+					ServerID:      server.ID,
+					Fault:         server.Fault,
+				}
+			}
+		}
+
+		if len(current) < count {
+			return false, nil
+		}
+
+		for _, server := range current {
+			if server.Status != "ACTIVE" {
+				return false, nil
+			}
+		}
+
+		members = current
+		return true, nil
+	})
+
+	return members, err
+}
@@ -0,0 +1,27 @@
+/*
+Package bulk holds the error returned by the BulkCreate functions of the
+networks, subnets and ports packages, which create several resources in a
+single request.
+
+The Networking service accepts a list of resources in the body of a create
+request, e.g. {"ports": [...]}, and creates either all of them or none of
+them. Deployments can disable this, in which case the request is rejected
+with a 400 error and an ErrNotSupported is returned instead; the resources
+then have to be created one at a time.
+
+Example to Fall Back to Single Creates
+
+	allPorts, err := ports.BulkCreate(networkClient, createOpts).Extract()
+	if _, ok := err.(bulk.ErrNotSupported); ok {
+		for _, opts := range createOpts {
+			port, err := ports.Create(networkClient, opts).Extract()
+			if err != nil {
+				panic(err)
+			}
+			allPorts = append(allPorts, *port)
+		}
+	} else if err != nil {
+		panic(err)
+	}
+*/
+package bulk
@@ -0,0 +1,20 @@
+package bulk
+
+import (
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrNotSupported is returned when the Networking service has bulk
+// operations disabled. Err holds the original 400 error, including the body
+// of the response.
+type ErrNotSupported struct {
+	gophercloud.BaseError
+	Resource string
+	Err      error
+}
+
+func (e ErrNotSupported) Error() string {
+	return fmt.Sprintf("The Networking service does not support bulk creation of %s: %s", e.Resource, e.Err)
+}
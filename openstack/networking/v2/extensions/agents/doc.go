@@ -0,0 +1,143 @@
+/*
+Package agents provides the ability to retrieve and manage Networking agents
+through the Neutron API.
+
+Example of Listing Agents
+
+	listOpts := agents.ListOpts{
+		AgentType: agents.AgentTypeL3,
+	}
+
+	allPages, err := agents.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAgents, err := agents.ExtractAgents(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, agent := range allAgents {
+		fmt.Printf("%+v\n", agent)
+	}
+
+Example to Get an Agent
+
+	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+	agent, err := agents.Get(networkClient, agentID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update an Agent
+
+	adminStateUp := true
+	description := "agent description"
+	updateOpts := &agents.UpdateOpts{
+		Description:  &description,
+		AdminStateUp: &adminStateUp,
+	}
+	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+	agent, err := agents.Update(networkClient, agentID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete an Agent
+
+	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+	err := agents.Delete(networkClient, agentID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Networks hosted by a DHCP Agent
+
+	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+	networks, err := agents.ListDHCPNetworks(networkClient, agentID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, network := range networks {
+		fmt.Printf("%+v\n", network)
+	}
+
+Example to Schedule a network to a DHCP Agent
+
+	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+	opts := &agents.ScheduleDHCPNetworkOpts{
+		NetworkID: "1ae075ca-708b-4e66-b4a7-b7698632f05f",
+	}
+	err := agents.ScheduleDHCPNetwork(networkClient, agentID, opts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove a network from a DHCP Agent
+
+	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+	networkID := "1ae075ca-708b-4e66-b4a7-b7698632f05f"
+	err := agents.RemoveDHCPNetwork(networkClient, agentID, networkID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Routers scheduled to a L3 Agent
+
+	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+	routers, err := agents.ListL3Routers(networkClient, agentID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Schedule a router to a L3 Agent
+
+	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+	opts := &agents.ScheduleL3RouterOpts{
+		RouterID: "43e66290-79a4-415d-9eb9-7ff7919839e1",
+	}
+	err := agents.ScheduleL3Router(networkClient, agentID, opts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove a router from a L3 Agent
+
+	agentID := "76af7b1f-d61b-4526-94f7-d2e14e2698df"
+	routerID := "43e66290-79a4-415d-9eb9-7ff7919839e1"
+	err := agents.RemoveL3Router(networkClient, agentID, routerID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to List the L3 Agents hosting a router
+
+	routerID := "43e66290-79a4-415d-9eb9-7ff7919839e1"
+	allPages, err := agents.ListL3AgentsHostingRouter(networkClient, routerID).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	l3Agents, err := agents.ExtractAgents(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, l3Agent := range l3Agents {
+		if l3Agent.HAState == agents.HAStateActive {
+			fmt.Printf("HA router is active on %s\n", l3Agent.Host)
+		}
+	}
+
+Example to move every router off a failing L3 Agent
+
+	moved, err := agents.MoveL3Routers(networkClient, failingAgentID, healthyAgentID)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Moved routers: %v\n", moved)
+*/
+package agents
@@ -0,0 +1,33 @@
+package agents
+
+import (
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrMoveL3RouterFailed is returned by MoveL3Routers when a router could not
+// be moved to the target L3 agent. Moved holds the IDs of the routers which
+// were moved before the failure.
+//
+// RollbackErr is set when the router could not be put back on the source
+// agent either, in which case the router is no longer hosted by any L3 agent
+// and has to be scheduled by hand.
+type ErrMoveL3RouterFailed struct {
+	gophercloud.BaseError
+	RouterID    string
+	FromAgentID string
+	ToAgentID   string
+	Moved       []string
+	Err         error
+	RollbackErr error
+}
+
+func (e ErrMoveL3RouterFailed) Error() string {
+	if e.RollbackErr != nil {
+		return fmt.Sprintf("Unable to move router %s from L3 agent %s to L3 agent %s: %s; "+
+			"unable to put it back on L3 agent %s, the router is not hosted by any L3 agent: %s",
+			e.RouterID, e.FromAgentID, e.ToAgentID, e.Err, e.FromAgentID, e.RollbackErr)
+	}
+	return fmt.Sprintf("Unable to move router %s from L3 agent %s to L3 agent %s: %s", e.RouterID, e.FromAgentID, e.ToAgentID, e.Err)
+}
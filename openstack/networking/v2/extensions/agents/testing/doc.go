@@ -0,0 +1,2 @@
+// agents unit tests
+package testing
@@ -0,0 +1,398 @@
+package testing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/chjlangzi/gophercloud"
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/agents"
+	"github.com/chjlangzi/gophercloud/pagination"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AgentsListResult)
+	})
+
+	count := 0
+
+	agents.List(fake.ServiceClient(), agents.ListOpts{}).EachPage(
+		func(page pagination.Page) (bool, error) {
+			count++
+			actual, err := agents.ExtractAgents(page)
+
+			if err != nil {
+				t.Errorf("Failed to extract agents: %v", err)
+				return false, nil
+			}
+
+			expected := []agents.Agent{
+				Agent1,
+				Agent2,
+			}
+
+			th.CheckDeepEquals(t, expected, actual)
+
+			return true, nil
+		})
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AgentsGetResult)
+	})
+
+	s, err := agents.Get(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, s.ID, "43583cf5-472e-4dc8-af5b-6aed4c94ee3a")
+	th.AssertEquals(t, s.Binary, "neutron-openvswitch-agent")
+	th.AssertEquals(t, s.AdminStateUp, true)
+	th.AssertEquals(t, s.Alive, true)
+	th.AssertEquals(t, s.Topic, "N/A")
+	th.AssertEquals(t, s.Host, "compute3")
+	th.AssertEquals(t, s.AgentType, "Open vSwitch agent")
+	th.AssertEquals(t, s.HeartbeatTimestamp, time.Date(2019, 1, 9, 11, 43, 01, 0, time.UTC))
+	th.AssertEquals(t, s.StartedAt, time.Date(2018, 6, 26, 21, 46, 20, 0, time.UTC))
+	th.AssertEquals(t, s.CreatedAt, time.Date(2017, 7, 26, 23, 2, 5, 0, time.UTC))
+	th.AssertDeepEquals(t, s.Configurations, map[string]interface{}{
+		"ovs_hybrid_plug":            false,
+		"datapath_type":              "system",
+		"vhostuser_socket_dir":       "/var/run/openvswitch",
+		"log_agent_heartbeats":       false,
+		"l2_population":              true,
+		"enable_distributed_routing": false,
+	})
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, AgentUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AgentUpdateResult)
+	})
+
+	iFalse := false
+	description := "My OVS agent for OpenStack"
+	updateOpts := &agents.UpdateOpts{
+		Description:  &description,
+		AdminStateUp: &iFalse,
+	}
+	s, err := agents.Update(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, s.Description, description)
+	th.AssertEquals(t, s.AdminStateUp, false)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := agents.Delete(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListDHCPNetworks(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/dhcp-networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AgentDHCPNetworksListResult)
+	})
+
+	s, err := agents.ListDHCPNetworks(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(s))
+	th.AssertEquals(t, "d32019d3-bc6e-4319-9c1d-6722fc136a22", s[0].ID)
+	th.AssertEquals(t, "net1", s[0].Name)
+	th.AssertDeepEquals(t, []string{"54d6f61d-db07-451c-9ab3-b9609b6b6f0b"}, s[0].Subnets)
+}
+
+func TestScheduleDHCPNetwork(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/dhcp-networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, ScheduleDHCPNetworkRequest)
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	opts := &agents.ScheduleDHCPNetworkOpts{
+		NetworkID: "1ae075ca-708b-4e66-b4a7-b7698632f05f",
+	}
+	err := agents.ScheduleDHCPNetwork(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRemoveDHCPNetwork(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/dhcp-networks/1ae075ca-708b-4e66-b4a7-b7698632f05f", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := agents.RemoveDHCPNetwork(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a", "1ae075ca-708b-4e66-b4a7-b7698632f05f").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListL3Routers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/l3-routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AgentL3RoutersListResult)
+	})
+
+	s, err := agents.ListL3Routers(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(s))
+	th.AssertEquals(t, "915a14a6-867b-4af7-83d1-70efceb146f9", s[0].ID)
+	th.AssertEquals(t, "router1", s[1].Name)
+}
+
+func TestScheduleL3Router(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/l3-routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, ScheduleL3RouterRequest)
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	opts := &agents.ScheduleL3RouterOpts{
+		RouterID: "43e66290-79a4-415d-9eb9-7ff7919839e1",
+	}
+	err := agents.ScheduleL3Router(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a", opts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestRemoveL3Router(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/l3-routers/43e66290-79a4-415d-9eb9-7ff7919839e1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := agents.RemoveL3Router(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a", "43e66290-79a4-415d-9eb9-7ff7919839e1").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestListL3AgentsHostingRouter(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/43e66290-79a4-415d-9eb9-7ff7919839e1/l3-agents", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, L3AgentsHostingRouterResult)
+	})
+
+	allPages, err := agents.ListL3AgentsHostingRouter(fake.ServiceClient(), "43e66290-79a4-415d-9eb9-7ff7919839e1").AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := agents.ExtractAgents(allPages)
+	th.AssertNoErr(t, err)
+
+	expected := Agent2
+	expected.HAState = agents.HAStateActive
+	th.CheckDeepEquals(t, []agents.Agent{expected}, actual)
+}
+
+func TestMoveL3Routers(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/l3-routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, AgentL3RoutersListResult)
+	})
+
+	var removed []string
+	for _, id := range []string{"915a14a6-867b-4af7-83d1-70efceb146f9", "f8a44de0-fc8e-45df-93c7-f79bf3b01c95"} {
+		id := id
+		th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/l3-routers/"+id, func(w http.ResponseWriter, r *http.Request) {
+			th.TestMethod(t, r, "DELETE")
+			removed = append(removed, id)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	var scheduled []string
+	th.Mux.HandleFunc("/v2.0/agents/59d75f7a-1aea-4a2a-9c24-3e9c7a40a5b0/l3-routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		b, err := ioutil.ReadAll(r.Body)
+		th.AssertNoErr(t, err)
+		scheduled = append(scheduled, string(b))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	moved, err := agents.MoveL3Routers(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a", "59d75f7a-1aea-4a2a-9c24-3e9c7a40a5b0")
+	th.AssertNoErr(t, err)
+
+	expected := []string{"915a14a6-867b-4af7-83d1-70efceb146f9", "f8a44de0-fc8e-45df-93c7-f79bf3b01c95"}
+	th.AssertDeepEquals(t, expected, moved)
+	th.AssertDeepEquals(t, expected, removed)
+	th.AssertEquals(t, 2, len(scheduled))
+}
+
+func TestMoveL3RoutersFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/l3-routers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, AgentL3RoutersListResult)
+		case "POST":
+			th.TestJSONRequest(t, r, `{"router_id": "915a14a6-867b-4af7-83d1-70efceb146f9"}`)
+			w.WriteHeader(http.StatusCreated)
+		}
+	})
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/l3-routers/915a14a6-867b-4af7-83d1-70efceb146f9", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/v2.0/agents/59d75f7a-1aea-4a2a-9c24-3e9c7a40a5b0/l3-routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	moved, err := agents.MoveL3Routers(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a", "59d75f7a-1aea-4a2a-9c24-3e9c7a40a5b0")
+	e, ok := err.(agents.ErrMoveL3RouterFailed)
+	if !ok {
+		t.Fatalf("Expected ErrMoveL3RouterFailed, got %v", err)
+	}
+	th.AssertEquals(t, "915a14a6-867b-4af7-83d1-70efceb146f9", e.RouterID)
+	th.AssertEquals(t, 0, len(moved))
+	if e.RollbackErr != nil {
+		t.Fatalf("Expected no rollback error, got %v", e.RollbackErr)
+	}
+}
+
+func TestMoveL3RoutersRollbackFailure(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/l3-routers", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			w.Header().Add("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, AgentL3RoutersListResult)
+		case "POST":
+			th.TestJSONRequest(t, r, `{"router_id": "915a14a6-867b-4af7-83d1-70efceb146f9"}`)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	th.Mux.HandleFunc("/v2.0/agents/43583cf5-472e-4dc8-af5b-6aed4c94ee3a/l3-routers/915a14a6-867b-4af7-83d1-70efceb146f9", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/v2.0/agents/59d75f7a-1aea-4a2a-9c24-3e9c7a40a5b0/l3-routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+	})
+
+	moved, err := agents.MoveL3Routers(fake.ServiceClient(), "43583cf5-472e-4dc8-af5b-6aed4c94ee3a", "59d75f7a-1aea-4a2a-9c24-3e9c7a40a5b0")
+	e, ok := err.(agents.ErrMoveL3RouterFailed)
+	if !ok {
+		t.Fatalf("Expected ErrMoveL3RouterFailed, got %v", err)
+	}
+	th.AssertEquals(t, "915a14a6-867b-4af7-83d1-70efceb146f9", e.RouterID)
+	th.AssertEquals(t, 0, len(moved))
+	if code, ok := e.Err.(gophercloud.ErrUnexpectedResponseCode); !ok || code.Actual != http.StatusConflict {
+		t.Fatalf("Expected the schedule error to be a 409, got %v", e.Err)
+	}
+	if _, ok := e.RollbackErr.(gophercloud.ErrDefault503); !ok {
+		t.Fatalf("Expected the rollback error to be a 503, got %v", e.RollbackErr)
+	}
+}
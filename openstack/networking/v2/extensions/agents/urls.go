@@ -0,0 +1,77 @@
+package agents
+
+import "github.com/chjlangzi/gophercloud"
+
+const (
+	agentsResourcePath   = "agents"
+	dhcpNetworksPath     = "dhcp-networks"
+	l3RoutersPath        = "l3-routers"
+	dhcpAgentsPath       = "dhcp-agents"
+	l3AgentsPath         = "l3-agents"
+	networksResourcePath = "networks"
+	routersResourcePath  = "routers"
+)
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(agentsResourcePath, id)
+}
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(agentsResourcePath)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, id string) string {
+	return resourceURL(c, id)
+}
+
+func dhcpNetworksURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(agentsResourcePath, id, dhcpNetworksPath)
+}
+
+func listDHCPNetworksURL(c *gophercloud.ServiceClient, id string) string {
+	return dhcpNetworksURL(c, id)
+}
+
+func scheduleDHCPNetworkURL(c *gophercloud.ServiceClient, id string) string {
+	return dhcpNetworksURL(c, id)
+}
+
+func removeDHCPNetworkURL(c *gophercloud.ServiceClient, id string, networkID string) string {
+	return c.ServiceURL(agentsResourcePath, id, dhcpNetworksPath, networkID)
+}
+
+func listDHCPAgentsHostingNetworkURL(c *gophercloud.ServiceClient, networkID string) string {
+	return c.ServiceURL(networksResourcePath, networkID, dhcpAgentsPath)
+}
+
+func l3RoutersURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(agentsResourcePath, id, l3RoutersPath)
+}
+
+func listL3RoutersURL(c *gophercloud.ServiceClient, id string) string {
+	return l3RoutersURL(c, id)
+}
+
+func scheduleL3RouterURL(c *gophercloud.ServiceClient, id string) string {
+	return l3RoutersURL(c, id)
+}
+
+func removeL3RouterURL(c *gophercloud.ServiceClient, id string, routerID string) string {
+	return c.ServiceURL(agentsResourcePath, id, l3RoutersPath, routerID)
+}
+
+func listL3AgentsHostingRouterURL(c *gophercloud.ServiceClient, routerID string) string {
+	return c.ServiceURL(routersResourcePath, routerID, l3AgentsPath)
+}
@@ -0,0 +1,44 @@
+package agents
+
+import "github.com/chjlangzi/gophercloud"
+
+// MoveL3Routers moves every router hosted by the L3 agent fromAgentID to the
+// L3 agent toAgentID, e.g. to evacuate a failing network node. Each router
+// is removed from the source agent before being scheduled to the target
+// agent; if scheduling fails the router is put back on the source agent and
+// an ErrMoveL3RouterFailed is returned. If putting it back fails too, the
+// error's RollbackErr is set and the router is left without an L3 agent. It
+// returns the IDs of the routers which were moved.
+func MoveL3Routers(c *gophercloud.ServiceClient, fromAgentID, toAgentID string) ([]string, error) {
+	hosted, err := ListL3Routers(c, fromAgentID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	moved := make([]string, 0, len(hosted))
+	for _, router := range hosted {
+		var rollbackErr error
+		err := RemoveL3Router(c, fromAgentID, router.ID).ExtractErr()
+		if err == nil {
+			err = ScheduleL3Router(c, toAgentID, ScheduleL3RouterOpts{RouterID: router.ID}).ExtractErr()
+			if err != nil {
+				rollbackErr = ScheduleL3Router(c, fromAgentID, ScheduleL3RouterOpts{RouterID: router.ID}).ExtractErr()
+			}
+		}
+
+		if err != nil {
+			return moved, ErrMoveL3RouterFailed{
+				RouterID:    router.ID,
+				FromAgentID: fromAgentID,
+				ToAgentID:   toAgentID,
+				Moved:       moved,
+				Err:         err,
+				RollbackErr: rollbackErr,
+			}
+		}
+
+		moved = append(moved, router.ID)
+	}
+
+	return moved, nil
+}
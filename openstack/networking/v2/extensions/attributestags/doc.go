@@ -0,0 +1,47 @@
+/*
+Package attributestags manages Tags on Resources created by the OpenStack
+Neutron Service.
+
+This enables tagging via a standard interface for resources types which
+support it. Resources which can be tagged are listed as Resource* constants,
+and their List functions accept the Tags, TagsAny, NotTags and NotTagsAny
+filters, each of which takes a comma separated list of tags.
+
+Example to ReplaceAll Resource Tags
+
+	network, err := networks.Create(conn, createOpts).Extract()
+
+	tagReplaceAllOpts := attributestags.ReplaceAllOpts{
+		Tags: []string{"abc", "123"},
+	}
+	attributestags.ReplaceAll(conn, attributestags.ResourceNetworks, network.ID, tagReplaceAllOpts)
+
+Example to List all Resource Tags
+
+	tags, err = attributestags.List(conn, attributestags.ResourceNetworks, network.ID).Extract()
+
+Example to Delete all Resource Tags
+
+	err = attributestags.DeleteAll(conn, attributestags.ResourceNetworks, network.ID).ExtractErr()
+
+Example to Add a tag to a Resource
+
+	err = attributestags.Add(client, attributestags.ResourceNetworks, network.ID, "atag").ExtractErr()
+
+Example to Delete a tag from a Resource
+
+	err = attributestags.Delete(client, attributestags.ResourceNetworks, network.ID, "atag").ExtractErr()
+
+Example to check if a Resource has a tag
+
+	exists, err := attributestags.Check(client, attributestags.ResourceNetworks, network.ID, "atag").Extract()
+
+Example to List Networks having all of the given tags
+
+	listOpts := networks.ListOpts{
+		Tags: "abc,123",
+	}
+
+	allPages, err := networks.List(client, listOpts).AllPages()
+*/
+package attributestags
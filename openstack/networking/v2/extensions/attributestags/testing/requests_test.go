@@ -0,0 +1,129 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/attributestags"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestReplaceAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/fakeid/tags", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, attributestagsReplaceAllRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, attributestagsReplaceAllResult)
+	})
+
+	opts := attributestags.ReplaceAllOpts{
+		Tags: []string{"abc", "xyz"},
+	}
+	res, err := attributestags.ReplaceAll(fake.ServiceClient(), attributestags.ResourceNetworks, "fakeid", opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, res, []string{"abc", "xyz"})
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/fakeid/tags", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, attributestagsListResult)
+	})
+
+	res, err := attributestags.List(fake.ServiceClient(), attributestags.ResourceNetworks, "fakeid").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, res, []string{"abc", "xyz"})
+}
+
+func TestDeleteAll(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/fakeid/tags", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := attributestags.DeleteAll(fake.ServiceClient(), attributestags.ResourceNetworks, "fakeid").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestAdd(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/fakeid/tags/atag", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	err := attributestags.Add(fake.ServiceClient(), attributestags.ResourcePorts, "fakeid", "atag").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/security-groups/fakeid/tags/atag", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := attributestags.Delete(fake.ServiceClient(), attributestags.ResourceSecurityGroups, "fakeid", "atag").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/fakeid/tags/atag", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	th.Mux.HandleFunc("/v2.0/routers/fakeid/tags/notexists", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	exists, err := attributestags.Check(fake.ServiceClient(), attributestags.ResourceRouters, "fakeid", "atag").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, true, exists)
+
+	exists, err = attributestags.Check(fake.ServiceClient(), attributestags.ResourceRouters, "fakeid", "notexists").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, false, exists)
+}
@@ -0,0 +1,31 @@
+package attributestags
+
+import "github.com/chjlangzi/gophercloud"
+
+const (
+	tagsPath = "tags"
+)
+
+func replaceURL(c *gophercloud.ServiceClient, rType string, id string) string {
+	return c.ServiceURL(rType, id, tagsPath)
+}
+
+func listURL(c *gophercloud.ServiceClient, rType string, id string) string {
+	return c.ServiceURL(rType, id, tagsPath)
+}
+
+func deleteAllURL(c *gophercloud.ServiceClient, rType string, id string) string {
+	return c.ServiceURL(rType, id, tagsPath)
+}
+
+func addURL(c *gophercloud.ServiceClient, rType string, id string, tag string) string {
+	return c.ServiceURL(rType, id, tagsPath, tag)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, rType string, id string, tag string) string {
+	return c.ServiceURL(rType, id, tagsPath, tag)
+}
+
+func checkURL(c *gophercloud.ServiceClient, rType string, id string, tag string) string {
+	return c.ServiceURL(rType, id, tagsPath, tag)
+}
@@ -0,0 +1,27 @@
+/*
+Package availabilityzones provides the ability to list the availability zones
+of the OpenStack Networking service. Networks and routers can be placed in
+them through their availability zone hints.
+
+Example of Listing the availability zones of routers
+
+	listOpts := availabilityzones.ListOpts{
+		Resource: availabilityzones.ResourceRouter,
+		State:    "available",
+	}
+
+	allPages, err := availabilityzones.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allZones, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, zone := range allZones {
+		fmt.Printf("%+v\n", zone)
+	}
+*/
+package availabilityzones
@@ -0,0 +1,43 @@
+package availabilityzones
+
+import (
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// AvailabilityZone represents a Neutron availability zone, which groups
+// network nodes hosting a given kind of resource.
+type AvailabilityZone struct {
+	// Name is the name of the availability zone.
+	Name string `json:"name"`
+
+	// Resource is the type of resource the availability zone is for, either
+	// "network" or "router".
+	Resource string `json:"resource"`
+
+	// State is the state of the availability zone, either "available" or
+	// "unavailable".
+	State string `json:"state"`
+}
+
+// AvailabilityZonePage stores a single page of AvailabilityZones from a
+// List() API call.
+type AvailabilityZonePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a AvailabilityZonePage is empty.
+func (r AvailabilityZonePage) IsEmpty() (bool, error) {
+	zones, err := ExtractAvailabilityZones(r)
+	return len(zones) == 0, err
+}
+
+// ExtractAvailabilityZones accepts a Page struct, specifically an
+// AvailabilityZonePage struct, and extracts the elements into a slice of
+// AvailabilityZone structs.
+func ExtractAvailabilityZones(r pagination.Page) ([]AvailabilityZone, error) {
+	var s struct {
+		AvailabilityZones []AvailabilityZone `json:"availability_zones"`
+	}
+	err := (r.(AvailabilityZonePage)).ExtractInto(&s)
+	return s.AvailabilityZones, err
+}
@@ -0,0 +1,25 @@
+package testing
+
+import "github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/availabilityzones"
+
+const ListResponse = `
+{
+    "availability_zones": [
+        {
+            "state": "available",
+            "resource": "network",
+            "name": "nova"
+        },
+        {
+            "state": "available",
+            "resource": "router",
+            "name": "nova"
+        }
+    ]
+}
+`
+
+var ExpectedAvailabilityZones = []availabilityzones.AvailabilityZone{
+	{Name: "nova", Resource: "network", State: "available"},
+	{Name: "nova", Resource: "router", State: "available"},
+}
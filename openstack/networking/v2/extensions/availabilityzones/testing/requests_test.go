@@ -0,0 +1,35 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/availabilityzones"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/availability_zones", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"state": "available"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	allPages, err := availabilityzones.List(fake.ServiceClient(), availabilityzones.ListOpts{State: "available"}).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := availabilityzones.ExtractAvailabilityZones(allPages)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, ExpectedAvailabilityZones, actual)
+}
@@ -0,0 +1,7 @@
+package availabilityzones
+
+import "github.com/chjlangzi/gophercloud"
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("availability_zones")
+}
@@ -0,0 +1,149 @@
+/*
+Package dns provides the ability to retrieve and manage the DNS integration
+attributes (dns_name, dns_domain and dns_assignment) of networks, ports and
+floating IPs through the Neutron dns-integration extension. It also provides
+helpers to cross-check the recordsets Neutron publishes to Designate.
+
+Example of Listing Ports with a DNS name
+
+	type PortWithDNSExt struct {
+		ports.Port
+		dns.PortDNSExt
+	}
+
+	var allPorts []PortWithDNSExt
+
+	portListOpts := ports.ListOpts{
+		NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+	}
+
+	listOpts := dns.PortListOptsExt{
+		ListOptsBuilder: portListOpts,
+		DNSName:         "test-port",
+	}
+
+	allPages, err := ports.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	err = ports.ExtractPortsInto(allPages, &allPorts)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, port := range allPorts {
+		fmt.Printf("%+v\n", port)
+	}
+
+Example of Getting a Port's DNS assignment
+
+	var port struct {
+		ports.Port
+		dns.PortDNSExt
+	}
+
+	portID := "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2"
+
+	err := ports.Get(networkClient, portID).ExtractInto(&port)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, assignment := range port.DNSAssignment {
+		fmt.Printf("%s => %s\n", assignment["fqdn"], assignment["ip_address"])
+	}
+
+Example of Creating a Port with a DNS name
+
+	var port struct {
+		ports.Port
+		dns.PortDNSExt
+	}
+
+	portCreateOpts := ports.CreateOpts{
+		NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+	}
+
+	createOpts := dns.PortCreateOptsExt{
+		CreateOptsBuilder: portCreateOpts,
+		DNSName:           "test-port",
+	}
+
+	err := ports.Create(networkClient, createOpts).ExtractInto(&port)
+	if err != nil {
+		panic(err)
+	}
+
+Example of Updating a Port's DNS name
+
+	dnsName := "test-port1"
+	updateOpts := dns.PortUpdateOptsExt{
+		UpdateOptsBuilder: ports.UpdateOpts{},
+		DNSName:           &dnsName,
+	}
+
+	portID := "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2"
+	_, err := ports.Update(networkClient, portID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Creating a Floating IP with a DNS name and domain
+
+	var fip struct {
+		floatingips.FloatingIP
+		dns.FloatingIPDNSExt
+	}
+
+	fipCreateOpts := floatingips.CreateOpts{
+		FloatingNetworkID: "a6917946-38ab-4ffd-a55a-26c0980ce5ee",
+	}
+
+	createOpts := dns.FloatingIPCreateOptsExt{
+		CreateOptsBuilder: fipCreateOpts,
+		DNSName:           "test-fip",
+		DNSDomain:         "example.org.",
+	}
+
+	err := floatingips.Create(networkClient, createOpts).ExtractInto(&fip)
+	if err != nil {
+		panic(err)
+	}
+
+Example of Listing Networks by DNS domain
+
+	listOpts := dns.NetworkListOptsExt{
+		ListOptsBuilder: networks.ListOpts{},
+		DNSDomain:       "example.org.",
+	}
+
+	allPages, err := networks.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Creating a Network with a DNS domain
+
+	createOpts := dns.NetworkCreateOptsExt{
+		CreateOptsBuilder: networks.CreateOpts{Name: "private"},
+		DNSDomain:         "example.org.",
+	}
+
+	_, err := networks.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Cross-checking the Designate recordsets of a Port
+
+	zoneID := "2150b1bf-dee2-4221-9d85-11f7886fb15f"
+
+	err := dns.CheckPortRecordSets(dnsClient, zoneID, "example.org.", port.PortDNSExt, port.FixedIPs)
+	if mismatch, ok := err.(dns.ErrRecordSetMismatch); ok {
+		fmt.Printf("missing: %v, unexpected: %v\n", mismatch.Missing, mismatch.Unexpected)
+	} else if err != nil {
+		panic(err)
+	}
+*/
+package dns
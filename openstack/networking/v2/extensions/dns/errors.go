@@ -0,0 +1,35 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrNoDNSName is returned by the recordset helpers when the resource to be
+// checked has no dns_name, so that no recordset is expected for it.
+type ErrNoDNSName struct {
+	gophercloud.BaseError
+	Resource string
+}
+
+func (e ErrNoDNSName) Error() string {
+	return fmt.Sprintf("%s has no dns_name, no recordset is published for it", e.Resource)
+}
+
+// ErrRecordSetMismatch is returned by the recordset helpers when the records
+// published in a Designate zone differ from the expected addresses.
+type ErrRecordSetMismatch struct {
+	gophercloud.BaseError
+	ZoneID     string
+	Name       string
+	Type       string
+	Missing    []string
+	Unexpected []string
+}
+
+func (e ErrRecordSetMismatch) Error() string {
+	return fmt.Sprintf("%s recordset %s in zone %s does not match: missing [%s], unexpected [%s]",
+		e.Type, e.Name, e.ZoneID, strings.Join(e.Missing, ", "), strings.Join(e.Unexpected, ", "))
+}
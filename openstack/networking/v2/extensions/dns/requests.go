@@ -0,0 +1,213 @@
+package dns
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/internal"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/networks"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/ports"
+)
+
+// PortListOptsExt adds DNS options to the base ports.ListOpts.
+type PortListOptsExt struct {
+	ports.ListOptsBuilder
+
+	// DNSName filters the ports by their dns_name.
+	DNSName string `q:"dns_name"`
+}
+
+// ToPortListQuery adds the dns_name filter to the base port list query.
+func (opts PortListOptsExt) ToPortListQuery() (string, error) {
+	base, err := opts.ListOptsBuilder.ToPortListQuery()
+	if err != nil {
+		return "", err
+	}
+
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return internal.MergeQuery(base, q.String()), nil
+}
+
+// PortCreateOptsExt adds DNS options to the base ports.CreateOpts.
+type PortCreateOptsExt struct {
+	ports.CreateOptsBuilder
+
+	// DNSName is the DNS name of the port.
+	DNSName string `json:"dns_name,omitempty"`
+}
+
+// ToPortCreateMap casts a CreateOpts struct to a map.
+func (opts PortCreateOptsExt) ToPortCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToPortCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+
+	if opts.DNSName != "" {
+		port["dns_name"] = opts.DNSName
+	}
+
+	return base, nil
+}
+
+// PortUpdateOptsExt adds DNS options to the base ports.UpdateOpts.
+type PortUpdateOptsExt struct {
+	ports.UpdateOptsBuilder
+
+	// DNSName is the DNS name of the port. Setting it to a pointer of an
+	// empty string will clear the DNS name of the port.
+	DNSName *string `json:"dns_name,omitempty"`
+}
+
+// ToPortUpdateMap casts an UpdateOpts struct to a map.
+func (opts PortUpdateOptsExt) ToPortUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToPortUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+
+	if opts.DNSName != nil {
+		port["dns_name"] = *opts.DNSName
+	}
+
+	return base, nil
+}
+
+// FloatingIPListOptsExt adds DNS options to the base floatingips.ListOpts.
+type FloatingIPListOptsExt struct {
+	floatingips.ListOptsBuilder
+
+	// DNSName filters the floating IPs by their dns_name.
+	DNSName string `q:"dns_name"`
+
+	// DNSDomain filters the floating IPs by their dns_domain.
+	DNSDomain string `q:"dns_domain"`
+}
+
+// ToFloatingIPListQuery adds the DNS filters to the base floating IP list
+// query.
+func (opts FloatingIPListOptsExt) ToFloatingIPListQuery() (string, error) {
+	base, err := opts.ListOptsBuilder.ToFloatingIPListQuery()
+	if err != nil {
+		return "", err
+	}
+
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return internal.MergeQuery(base, q.String()), nil
+}
+
+// FloatingIPCreateOptsExt adds DNS options to the base floatingips.CreateOpts.
+// The DNS attributes of a floating IP can only be set on creation.
+type FloatingIPCreateOptsExt struct {
+	floatingips.CreateOptsBuilder
+
+	// DNSName is the DNS name of the floating IP.
+	DNSName string `json:"dns_name,omitempty"`
+
+	// DNSDomain is the DNS domain of the floating IP. It must end with a dot.
+	DNSDomain string `json:"dns_domain,omitempty"`
+}
+
+// ToFloatingIPCreateMap casts a CreateOpts struct to a map.
+func (opts FloatingIPCreateOptsExt) ToFloatingIPCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToFloatingIPCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	floatingIP := base["floatingip"].(map[string]interface{})
+
+	if opts.DNSName != "" {
+		floatingIP["dns_name"] = opts.DNSName
+	}
+
+	if opts.DNSDomain != "" {
+		floatingIP["dns_domain"] = opts.DNSDomain
+	}
+
+	return base, nil
+}
+
+// NetworkListOptsExt adds DNS options to the base networks.ListOpts.
+type NetworkListOptsExt struct {
+	networks.ListOptsBuilder
+
+	// DNSDomain filters the networks by their dns_domain.
+	DNSDomain string `q:"dns_domain"`
+}
+
+// ToNetworkListQuery adds the dns_domain filter to the base network list
+// query.
+func (opts NetworkListOptsExt) ToNetworkListQuery() (string, error) {
+	base, err := opts.ListOptsBuilder.ToNetworkListQuery()
+	if err != nil {
+		return "", err
+	}
+
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return internal.MergeQuery(base, q.String()), nil
+}
+
+// NetworkCreateOptsExt adds DNS options to the base networks.CreateOpts.
+type NetworkCreateOptsExt struct {
+	networks.CreateOptsBuilder
+
+	// DNSDomain is the DNS domain of the network. It must end with a dot.
+	DNSDomain string `json:"dns_domain,omitempty"`
+}
+
+// ToNetworkCreateMap casts a CreateOpts struct to a map.
+func (opts NetworkCreateOptsExt) ToNetworkCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToNetworkCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	network := base["network"].(map[string]interface{})
+
+	if opts.DNSDomain != "" {
+		network["dns_domain"] = opts.DNSDomain
+	}
+
+	return base, nil
+}
+
+// NetworkUpdateOptsExt adds DNS options to the base networks.UpdateOpts.
+type NetworkUpdateOptsExt struct {
+	networks.UpdateOptsBuilder
+
+	// DNSDomain is the DNS domain of the network. Setting it to a pointer of
+	// an empty string will clear the DNS domain of the network.
+	DNSDomain *string `json:"dns_domain,omitempty"`
+}
+
+// ToNetworkUpdateMap casts an UpdateOpts struct to a map.
+func (opts NetworkUpdateOptsExt) ToNetworkUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToNetworkUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	network := base["network"].(map[string]interface{})
+
+	if opts.DNSDomain != nil {
+		network["dns_domain"] = *opts.DNSDomain
+	}
+
+	return base, nil
+}
@@ -0,0 +1,2 @@
+// dns unit tests
+package testing
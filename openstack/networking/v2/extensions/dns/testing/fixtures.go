@@ -0,0 +1,358 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+// PortListResponse is a sample response to a ports List call filtered by
+// dns_name.
+const PortListResponse = `
+{
+    "ports": [
+        {
+            "status": "ACTIVE",
+            "name": "",
+            "admin_state_up": true,
+            "network_id": "70c1db1f-b701-45bd-96e0-a313ee3430b3",
+            "tenant_id": "",
+            "device_owner": "network:router_gateway",
+            "mac_address": "fa:16:3e:58:42:ed",
+            "fixed_ips": [
+                {
+                    "subnet_id": "008ba151-0b8c-4a67-98b5-0d2b87666062",
+                    "ip_address": "172.24.4.2"
+                }
+            ],
+            "id": "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b",
+            "security_groups": [],
+            "device_id": "9ae135f4-b6e0-4dad-9e91-3c223e385824",
+            "dns_name": "test-port",
+            "dns_assignment": [
+                {
+                    "hostname": "test-port",
+                    "ip_address": "172.24.4.2",
+                    "fqdn": "test-port.openstack.local."
+                }
+            ]
+        }
+    ]
+}
+`
+
+// PortGetResponse is a sample response to a ports Get call.
+const PortGetResponse = `
+{
+    "port": {
+        "status": "ACTIVE",
+        "name": "",
+        "admin_state_up": true,
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "tenant_id": "7e02058126cc4950b75f9970368ba177",
+        "device_owner": "network:router_interface",
+        "mac_address": "fa:16:3e:23:fd:d7",
+        "fixed_ips": [
+            {
+                "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                "ip_address": "10.0.0.1"
+            }
+        ],
+        "id": "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2",
+        "security_groups": [],
+        "device_id": "5e3898d7-11be-483e-9732-b2f5eccd2b2e",
+        "dns_name": "test-port",
+        "dns_assignment": [
+            {
+                "hostname": "test-port",
+                "ip_address": "10.0.0.1",
+                "fqdn": "test-port.openstack.local."
+            }
+        ]
+    }
+}
+`
+
+// PortCreateRequest is a sample request to a ports Create call with a
+// dns_name.
+const PortCreateRequest = `
+{
+    "port": {
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "name": "private-port",
+        "admin_state_up": true,
+        "fixed_ips": [
+            {
+                "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                "ip_address": "10.0.0.2"
+            }
+        ],
+        "security_groups": ["foo"],
+        "dns_name": "test-port"
+    }
+}
+`
+
+// PortCreateResponse is a sample response to a ports Create call with a
+// dns_name.
+const PortCreateResponse = `
+{
+    "port": {
+        "status": "DOWN",
+        "name": "private-port",
+        "admin_state_up": true,
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+        "device_owner": "",
+        "mac_address": "fa:16:3e:c9:cb:f0",
+        "fixed_ips": [
+            {
+                "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                "ip_address": "10.0.0.2"
+            }
+        ],
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+        "security_groups": ["f0ac4394-7e4a-4409-9701-ba8be283dbc3"],
+        "device_id": "",
+        "dns_name": "test-port",
+        "dns_assignment": [
+            {
+                "hostname": "test-port",
+                "ip_address": "10.0.0.2",
+                "fqdn": "test-port.openstack.local."
+            }
+        ]
+    }
+}
+`
+
+// PortUpdateRequest is a sample request to a ports Update call with a
+// dns_name.
+const PortUpdateRequest = `
+{
+    "port": {
+        "name": "new_port_name",
+        "dns_name": "test-port1"
+    }
+}
+`
+
+// PortUpdateResponse is a sample response to a ports Update call with a
+// dns_name.
+const PortUpdateResponse = `
+{
+    "port": {
+        "status": "DOWN",
+        "name": "new_port_name",
+        "admin_state_up": true,
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+        "device_owner": "",
+        "mac_address": "fa:16:3e:c9:cb:f0",
+        "fixed_ips": [
+            {
+                "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                "ip_address": "10.0.0.3"
+            }
+        ],
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+        "security_groups": ["f0ac4394-7e4a-4409-9701-ba8be283dbc3"],
+        "device_id": "",
+        "dns_name": "test-port1",
+        "dns_assignment": [
+            {
+                "hostname": "test-port1",
+                "ip_address": "10.0.0.3",
+                "fqdn": "test-port1.openstack.local."
+            }
+        ]
+    }
+}
+`
+
+// FloatingIPListResponse is a sample response to a floating IPs List call
+// filtered by dns_name and dns_domain.
+const FloatingIPListResponse = `
+{
+    "floatingips": [
+        {
+            "floating_network_id": "6d67c30a-ddb4-49a1-bec3-a65b286b4170",
+            "router_id": null,
+            "fixed_ip_address": null,
+            "floating_ip_address": "192.0.0.4",
+            "tenant_id": "017d8de156df4177889f31a9bd6edc00",
+            "status": "DOWN",
+            "port_id": null,
+            "id": "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e",
+            "dns_name": "test-fip",
+            "dns_domain": "example.org."
+        }
+    ]
+}
+`
+
+// FloatingIPCreateRequest is a sample request to a floating IPs Create call
+// with a dns_name and dns_domain.
+const FloatingIPCreateRequest = `
+{
+    "floatingip": {
+        "floating_network_id": "6d67c30a-ddb4-49a1-bec3-a65b286b4170",
+        "dns_name": "test-fip",
+        "dns_domain": "example.org."
+    }
+}
+`
+
+// FloatingIPCreateResponse is a sample response to a floating IPs Create call
+// with a dns_name and dns_domain.
+const FloatingIPCreateResponse = `
+{
+    "floatingip": {
+        "floating_network_id": "6d67c30a-ddb4-49a1-bec3-a65b286b4170",
+        "router_id": null,
+        "fixed_ip_address": null,
+        "floating_ip_address": "192.0.0.4",
+        "tenant_id": "017d8de156df4177889f31a9bd6edc00",
+        "status": "DOWN",
+        "port_id": null,
+        "id": "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e",
+        "dns_name": "test-fip",
+        "dns_domain": "example.org."
+    }
+}
+`
+
+// NetworkListResponse is a sample response to a networks List call filtered
+// by dns_domain.
+const NetworkListResponse = `
+{
+    "networks": [
+        {
+            "status": "ACTIVE",
+            "subnets": [
+                "54d6f61d-db07-451c-9ab3-b9609b6b6f0b"
+            ],
+            "name": "public",
+            "admin_state_up": true,
+            "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+            "shared": true,
+            "id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "dns_domain": "local."
+        }
+    ]
+}
+`
+
+// NetworkCreateRequest is a sample request to a networks Create call with a
+// dns_domain.
+const NetworkCreateRequest = `
+{
+    "network": {
+        "name": "private",
+        "admin_state_up": true,
+        "dns_domain": "local."
+    }
+}
+`
+
+// NetworkCreateResponse is a sample response to a networks Create call with
+// a dns_domain.
+const NetworkCreateResponse = `
+{
+    "network": {
+        "status": "ACTIVE",
+        "subnets": [],
+        "name": "private",
+        "admin_state_up": true,
+        "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+        "shared": false,
+        "id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+        "dns_domain": "local."
+    }
+}
+`
+
+// NetworkUpdateRequest is a sample request to a networks Update call which
+// clears the dns_domain.
+const NetworkUpdateRequest = `
+{
+    "network": {
+        "name": "new_network_name",
+        "dns_domain": ""
+    }
+}
+`
+
+// NetworkUpdateResponse is a sample response to a networks Update call which
+// clears the dns_domain.
+const NetworkUpdateResponse = `
+{
+    "network": {
+        "status": "ACTIVE",
+        "subnets": [],
+        "name": "new_network_name",
+        "admin_state_up": true,
+        "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+        "shared": false,
+        "id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+        "dns_domain": ""
+    }
+}
+`
+
+// RecordSetListResponse is a sample response to a Designate recordsets
+// ListByZone call filtered by name and type.
+const RecordSetListResponse = `
+{
+    "recordsets": [
+        {
+            "links": {
+                "self": "https://127.0.0.1:9001/v2/zones/2150b1bf-dee2-4221-9d85-11f7886fb15f/recordsets/f7b10e9b-0cae-4a91-b162-562bc6096648"
+            },
+            "records": [
+                "10.0.0.1",
+                "10.0.0.2"
+            ],
+            "ttl": 3600,
+            "id": "f7b10e9b-0cae-4a91-b162-562bc6096648",
+            "name": "test-port.example.org.",
+            "project_id": "4335d1f0-f793-11e2-b778-0800200c9a66",
+            "zone_id": "2150b1bf-dee2-4221-9d85-11f7886fb15f",
+            "zone_name": "example.org.",
+            "created_at": "2014-10-24T19:59:44.000000",
+            "updated_at": null,
+            "version": 1,
+            "type": "A",
+            "status": "ACTIVE",
+            "action": "NONE"
+        }
+    ],
+    "links": {
+        "self": "http://127.0.0.1:9001/v2/zones/2150b1bf-dee2-4221-9d85-11f7886fb15f/recordsets"
+    },
+    "metadata": {
+        "total_count": 1
+    }
+}
+`
+
+// HandleRecordSetListSuccessfully configures the test server to respond to a
+// Designate recordsets ListByZone request.
+func HandleRecordSetListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/zones/2150b1bf-dee2-4221-9d85-11f7886fb15f/recordsets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name": "test-port.example.org.",
+			"type": "A",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, RecordSetListResponse)
+	})
+}
@@ -0,0 +1,408 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud"
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/dns"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/networks"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/ports"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+type PortDNS struct {
+	ports.Port
+	dns.PortDNSExt
+}
+
+type FloatingIPDNS struct {
+	floatingips.FloatingIP
+	dns.FloatingIPDNSExt
+}
+
+type NetworkDNS struct {
+	networks.Network
+	dns.NetworkDNSExt
+}
+
+func TestPortList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_id": "70c1db1f-b701-45bd-96e0-a313ee3430b3",
+			"dns_name":   "test-port",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, PortListResponse)
+	})
+
+	listOpts := dns.PortListOptsExt{
+		ListOptsBuilder: ports.ListOpts{
+			NetworkID: "70c1db1f-b701-45bd-96e0-a313ee3430b3",
+		},
+		DNSName: "test-port",
+	}
+
+	allPages, err := ports.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	var actual []PortDNS
+	err = ports.ExtractPortsInto(allPages, &actual)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "d80b1a3b-4fc1-49f3-952e-1e2ab7081d8b", actual[0].ID)
+	th.AssertEquals(t, "test-port", actual[0].DNSName)
+	th.AssertDeepEquals(t, []map[string]string{
+		{
+			"hostname":   "test-port",
+			"ip_address": "172.24.4.2",
+			"fqdn":       "test-port.openstack.local.",
+		},
+	}, actual[0].DNSAssignment)
+}
+
+func TestPortGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, PortGetResponse)
+	})
+
+	var s PortDNS
+	err := ports.Get(fake.ServiceClient(), "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2").ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", s.ID)
+	th.AssertEquals(t, "test-port", s.DNSName)
+	th.AssertEquals(t, "test-port.openstack.local.", s.DNSAssignment[0]["fqdn"])
+	th.AssertEquals(t, "10.0.0.1", s.DNSAssignment[0]["ip_address"])
+}
+
+func TestPortCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, PortCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, PortCreateResponse)
+	})
+
+	asu := true
+	portCreateOpts := ports.CreateOpts{
+		Name:         "private-port",
+		AdminStateUp: &asu,
+		NetworkID:    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		FixedIPs: []ports.IP{
+			{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2", IPAddress: "10.0.0.2"},
+		},
+		SecurityGroups: &[]string{"foo"},
+	}
+
+	createOpts := dns.PortCreateOptsExt{
+		CreateOptsBuilder: portCreateOpts,
+		DNSName:           "test-port",
+	}
+
+	var s PortDNS
+	err := ports.Create(fake.ServiceClient(), createOpts).ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "65c0ee9f-d634-4522-8954-51021b570b0d", s.ID)
+	th.AssertEquals(t, "test-port", s.DNSName)
+	th.AssertEquals(t, "test-port", s.DNSAssignment[0]["hostname"])
+}
+
+func TestPortRequiredCreateOpts(t *testing.T) {
+	res := ports.Create(fake.ServiceClient(), dns.PortCreateOptsExt{CreateOptsBuilder: ports.CreateOpts{}})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestPortUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/65c0ee9f-d634-4522-8954-51021b570b0d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, PortUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, PortUpdateResponse)
+	})
+
+	dnsName := "test-port1"
+	updateOpts := dns.PortUpdateOptsExt{
+		UpdateOptsBuilder: ports.UpdateOpts{Name: "new_port_name"},
+		DNSName:           &dnsName,
+	}
+
+	var s PortDNS
+	err := ports.Update(fake.ServiceClient(), "65c0ee9f-d634-4522-8954-51021b570b0d", updateOpts).ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "new_port_name", s.Name)
+	th.AssertEquals(t, "test-port1", s.DNSName)
+	th.AssertEquals(t, "test-port1.openstack.local.", s.DNSAssignment[0]["fqdn"])
+}
+
+func TestFloatingIPList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"port_id":    "ce705c24-c1ef-408a-bda3-7bbd946164ab",
+			"dns_name":   "test-fip",
+			"dns_domain": "example.org.",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, FloatingIPListResponse)
+	})
+
+	listOpts := dns.FloatingIPListOptsExt{
+		ListOptsBuilder: floatingips.ListOpts{
+			PortID: "ce705c24-c1ef-408a-bda3-7bbd946164ab",
+		},
+		DNSName:   "test-fip",
+		DNSDomain: "example.org.",
+	}
+
+	allPages, err := floatingips.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	var actual []FloatingIPDNS
+	err = floatingips.ExtractFloatingIPsInto(allPages, &actual)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "2f95fd2b-9f6a-4e8e-9e9a-2cbe286cbf9e", actual[0].ID)
+	th.AssertEquals(t, "test-fip", actual[0].DNSName)
+	th.AssertEquals(t, "example.org.", actual[0].DNSDomain)
+}
+
+func TestFloatingIPCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, FloatingIPCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, FloatingIPCreateResponse)
+	})
+
+	createOpts := dns.FloatingIPCreateOptsExt{
+		CreateOptsBuilder: floatingips.CreateOpts{
+			FloatingNetworkID: "6d67c30a-ddb4-49a1-bec3-a65b286b4170",
+		},
+		DNSName:   "test-fip",
+		DNSDomain: "example.org.",
+	}
+
+	var s FloatingIPDNS
+	err := floatingips.Create(fake.ServiceClient(), createOpts).ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "192.0.0.4", s.FloatingIP.FloatingIP)
+	th.AssertEquals(t, "test-fip", s.DNSName)
+	th.AssertEquals(t, "example.org.", s.DNSDomain)
+}
+
+func TestNetworkList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name":       "public",
+			"dns_domain": "local.",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, NetworkListResponse)
+	})
+
+	listOpts := dns.NetworkListOptsExt{
+		ListOptsBuilder: networks.ListOpts{Name: "public"},
+		DNSDomain:       "local.",
+	}
+
+	allPages, err := networks.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	var actual []NetworkDNS
+	err = networks.ExtractNetworksInto(allPages, &actual)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertEquals(t, "d32019d3-bc6e-4319-9c1d-6722fc136a22", actual[0].ID)
+	th.AssertEquals(t, "local.", actual[0].DNSDomain)
+}
+
+func TestNetworkCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, NetworkCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, NetworkCreateResponse)
+	})
+
+	createOpts := dns.NetworkCreateOptsExt{
+		CreateOptsBuilder: networks.CreateOpts{
+			Name:         "private",
+			AdminStateUp: gophercloud.Enabled,
+		},
+		DNSDomain: "local.",
+	}
+
+	var s NetworkDNS
+	err := networks.Create(fake.ServiceClient(), createOpts).ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "db193ab3-96e3-4cb3-8fc5-05f4296d0324", s.ID)
+	th.AssertEquals(t, "local.", s.DNSDomain)
+}
+
+func TestNetworkUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/db193ab3-96e3-4cb3-8fc5-05f4296d0324", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, NetworkUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, NetworkUpdateResponse)
+	})
+
+	dnsDomain := ""
+	updateOpts := dns.NetworkUpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{Name: "new_network_name"},
+		DNSDomain:         &dnsDomain,
+	}
+
+	var s NetworkDNS
+	err := networks.Update(fake.ServiceClient(), "db193ab3-96e3-4cb3-8fc5-05f4296d0324", updateOpts).ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "new_network_name", s.Name)
+	th.AssertEquals(t, "", s.DNSDomain)
+}
+
+func TestFQDN(t *testing.T) {
+	th.AssertEquals(t, "test-port.example.org.", dns.FQDN("test-port", "example.org."))
+	th.AssertEquals(t, "test-port.example.org.", dns.FQDN("test-port", "example.org"))
+	th.AssertEquals(t, "test-port.", dns.FQDN("test-port", ""))
+}
+
+func TestCheckPortRecordSets(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleRecordSetListSuccessfully(t)
+
+	port := dns.PortDNSExt{DNSName: "test-port"}
+	fixedIPs := []ports.IP{
+		{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2", IPAddress: "10.0.0.2"},
+		{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2", IPAddress: "10.0.0.1"},
+	}
+
+	err := dns.CheckPortRecordSets(client.ServiceClient(), "2150b1bf-dee2-4221-9d85-11f7886fb15f", "example.org.", port, fixedIPs)
+	th.AssertNoErr(t, err)
+}
+
+func TestCheckRecordSetsMismatch(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleRecordSetListSuccessfully(t)
+
+	err := dns.CheckRecordSets(client.ServiceClient(), "2150b1bf-dee2-4221-9d85-11f7886fb15f", "test-port.example.org", []string{"10.0.0.1", "10.0.0.3"})
+
+	mismatch, ok := err.(dns.ErrRecordSetMismatch)
+	if !ok {
+		t.Fatalf("Expected ErrRecordSetMismatch, got %v", err)
+	}
+
+	th.AssertEquals(t, "A", mismatch.Type)
+	th.AssertEquals(t, "test-port.example.org.", mismatch.Name)
+	th.AssertDeepEquals(t, []string{"10.0.0.3"}, mismatch.Missing)
+	th.AssertDeepEquals(t, []string{"10.0.0.2"}, mismatch.Unexpected)
+}
+
+func TestCheckRecordSetsInvalidAddress(t *testing.T) {
+	err := dns.CheckRecordSets(client.ServiceClient(), "2150b1bf-dee2-4221-9d85-11f7886fb15f", "test-port.example.org.", []string{"not-an-ip"})
+	if _, ok := err.(gophercloud.ErrInvalidInput); !ok {
+		t.Fatalf("Expected ErrInvalidInput, got %v", err)
+	}
+}
+
+func TestCheckFloatingIPRecordSetsNoDNSName(t *testing.T) {
+	err := dns.CheckFloatingIPRecordSets(client.ServiceClient(), "2150b1bf-dee2-4221-9d85-11f7886fb15f", "192.0.0.4", dns.FloatingIPDNSExt{})
+	if _, ok := err.(dns.ErrNoDNSName); !ok {
+		t.Fatalf("Expected ErrNoDNSName, got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package dns
+
+import (
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/dns/v2/recordsets"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/ports"
+)
+
+// FQDN joins a dns_name and a dns_domain into the fully qualified name used
+// by Designate, which always ends with a dot.
+func FQDN(name, domain string) string {
+	fqdn := strings.TrimSuffix(name, ".")
+	if domain = strings.Trim(domain, "."); domain != "" {
+		fqdn += "." + domain
+	}
+	return fqdn + "."
+}
+
+// CheckRecordSets cross-checks the A and AAAA recordsets of name in the
+// Designate zone zoneID against the given addresses. IPv4 addresses are
+// expected in A records and IPv6 addresses in AAAA records; a record type is
+// only checked if at least one address of its family is given.
+//
+// The client must be a DNS v2 service client. An ErrRecordSetMismatch is
+// returned for the first record type whose published records differ from the
+// expected addresses.
+func CheckRecordSets(client *gophercloud.ServiceClient, zoneID, name string, addresses []string) error {
+	expected := map[string][]string{}
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			err := gophercloud.ErrInvalidInput{}
+			err.Argument = "addresses"
+			err.Value = address
+			return err
+		}
+		if ip.To4() != nil {
+			expected["A"] = append(expected["A"], ip.String())
+		} else {
+			expected["AAAA"] = append(expected["AAAA"], ip.String())
+		}
+	}
+
+	if !strings.HasSuffix(name, ".") {
+		name += "."
+	}
+
+	for _, rrType := range []string{"A", "AAAA"} {
+		if len(expected[rrType]) == 0 {
+			continue
+		}
+
+		listOpts := recordsets.ListOpts{
+			Name: name,
+			Type: rrType,
+		}
+
+		allPages, err := recordsets.ListByZone(client, zoneID, listOpts).AllPages()
+		if err != nil {
+			return err
+		}
+
+		allRRs, err := recordsets.ExtractRecordSets(allPages)
+		if err != nil {
+			return err
+		}
+
+		var published []string
+		for _, rr := range allRRs {
+			for _, record := range rr.Records {
+				if ip := net.ParseIP(record); ip != nil {
+					record = ip.String()
+				}
+				published = append(published, record)
+			}
+		}
+
+		missing, unexpected := diffRecords(expected[rrType], published)
+		if len(missing) > 0 || len(unexpected) > 0 {
+			return ErrRecordSetMismatch{
+				ZoneID:     zoneID,
+				Name:       name,
+				Type:       rrType,
+				Missing:    missing,
+				Unexpected: unexpected,
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckPortRecordSets cross-checks the recordsets published for a port in
+// the Designate zone zoneID. dnsDomain is the dns_domain of the port's
+// network, which together with the dns_name of the port forms the published
+// name, and fixedIPs are the fixed IPs of the port.
+func CheckPortRecordSets(client *gophercloud.ServiceClient, zoneID, dnsDomain string, port PortDNSExt, fixedIPs []ports.IP) error {
+	if port.DNSName == "" {
+		return ErrNoDNSName{Resource: "port"}
+	}
+
+	addresses := make([]string, 0, len(fixedIPs))
+	for _, fixedIP := range fixedIPs {
+		addresses = append(addresses, fixedIP.IPAddress)
+	}
+
+	return CheckRecordSets(client, zoneID, FQDN(port.DNSName, dnsDomain), addresses)
+}
+
+// CheckFloatingIPRecordSets cross-checks the A or AAAA recordset published
+// for a floating IP with the given address in the Designate zone zoneID.
+func CheckFloatingIPRecordSets(client *gophercloud.ServiceClient, zoneID, address string, floatingIP FloatingIPDNSExt) error {
+	if floatingIP.DNSName == "" {
+		return ErrNoDNSName{Resource: "floating IP " + address}
+	}
+
+	return CheckRecordSets(client, zoneID, FQDN(floatingIP.DNSName, floatingIP.DNSDomain), []string{address})
+}
+
+// diffRecords returns the expected records which are not published and the
+// published records which are not expected, both sorted.
+func diffRecords(expected, published []string) (missing, unexpected []string) {
+	want := map[string]bool{}
+	for _, record := range expected {
+		want[record] = true
+	}
+
+	have := map[string]bool{}
+	for _, record := range published {
+		have[record] = true
+		if !want[record] {
+			unexpected = append(unexpected, record)
+		}
+	}
+
+	for record := range want {
+		if !have[record] {
+			missing = append(missing, record)
+		}
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+	return missing, unexpected
+}
@@ -1,3 +1,6 @@
 // Package fwaas provides information and interaction with the Firewall
 // as a Service extension for the OpenStack Networking service.
+//
+// FWaaS v1 has been removed from Neutron. New code should use the fwaas_v2
+// packages, whose documentation describes how the v1 types map to v2.
 package fwaas
@@ -0,0 +1,27 @@
+/*
+Package fwaas_v2 provides information and interaction with the Firewall
+as a Service v2 extension for the OpenStack Networking service.
+
+FWaaS v1, implemented in the fwaas package, has been removed from Neutron.
+When migrating, note that the v2 resources live under the /fwaas path
+instead of /fw, and that the types map as follows:
+
+	fwaas/firewalls.Firewall        -> fwaas_v2/groups.Group
+	fwaas/policies.Policy           -> fwaas_v2/policies.Policy
+	fwaas/rules.Rule                -> fwaas_v2/rules.Rule
+	fwaas/routerinsertion.*         -> fwaas_v2/groups.CreateOpts.Ports
+
+A v1 firewall applied a single policy to all the interfaces of a set of
+routers. A v2 firewall group applies an ingress and an egress policy, set with
+IngressFirewallPolicyID and EgressFirewallPolicyID, to a set of ports, which
+may be router interfaces or VM ports. The v1 PolicyID is equivalent to using
+the same policy for both directions, and the routers of the routerinsertion
+extension are replaced by the IDs of their interface ports.
+
+Policies keep their ordered list of rules, and policies.AddRule becomes
+policies.InsertRule. Rules no longer carry the ID and the position of their
+single policy, since a rule may now belong to several policies; see
+rules.Rule.FirewallPolicyID. Rules also gain the "reject" action and may
+match on the source and destination firewall groups.
+*/
+package fwaas_v2
@@ -0,0 +1,79 @@
+/*
+Package groups allows management and retrieval of FWaaS v2 Firewall Groups
+in the OpenStack Networking Service.
+
+A firewall group applies an ingress and an egress firewall policy to the
+ports it is associated with. It replaces the v1 firewall and its router
+insertion extension.
+
+Example to List Groups
+
+	listOpts := groups.ListOpts{
+		TenantID: "tenant-id",
+	}
+
+	allPages, err := groups.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allGroups, err := groups.ExtractGroups(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, group := range allGroups {
+		fmt.Printf("%+v\n", group)
+	}
+
+Example to Create a Group
+
+	createOpts := groups.CreateOpts{
+		Name:                    "group_1",
+		Description:             "A firewall group",
+		IngressFirewallPolicyID: "19ab8c87-4a32-4e6a-a74e-b77fffb89a0c",
+		EgressFirewallPolicyID:  "19ab8c87-4a32-4e6a-a74e-b77fffb89a0c",
+		Ports: []string{
+			"a6af1e56-b12b-4733-8f77-49166afd5719",
+		},
+	}
+
+	group, err := groups.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Associate a Group with other Ports
+
+	groupID := "a6917946-38ab-4ffd-a55a-26c0980ce5ee"
+	ports := []string{
+		"a6af1e56-b12b-4733-8f77-49166afd5719",
+		"e5d3ba2b-56c5-4f5b-b3c6-ba4cf4e7a8d5",
+	}
+
+	updateOpts := groups.UpdateOpts{
+		Ports: &ports,
+	}
+
+	group, err := groups.Update(networkClient, groupID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove the Egress Policy of a Group
+
+	groupID := "a6917946-38ab-4ffd-a55a-26c0980ce5ee"
+	group, err := groups.RemoveEgressPolicy(networkClient, groupID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Group
+
+	groupID := "a6917946-38ab-4ffd-a55a-26c0980ce5ee"
+	err := groups.Delete(networkClient, groupID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package groups
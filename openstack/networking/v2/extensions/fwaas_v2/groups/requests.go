@@ -0,0 +1,189 @@
+package groups
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToGroupListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the firewall group attributes you want to see returned. SortKey allows you
+// to sort by a particular firewall group attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	TenantID                string `q:"tenant_id"`
+	ProjectID               string `q:"project_id"`
+	Name                    string `q:"name"`
+	Description             string `q:"description"`
+	IngressFirewallPolicyID string `q:"ingress_firewall_policy_id"`
+	EgressFirewallPolicyID  string `q:"egress_firewall_policy_id"`
+	AdminStateUp            *bool  `q:"admin_state_up"`
+	Shared                  *bool  `q:"shared"`
+	Status                  string `q:"status"`
+	ID                      string `q:"id"`
+	Limit                   int    `q:"limit"`
+	Marker                  string `q:"marker"`
+	SortKey                 string `q:"sort_key"`
+	SortDir                 string `q:"sort_dir"`
+}
+
+// ToGroupListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToGroupListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// firewall groups. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+//
+// Default policy settings return only those firewall groups that are owned by
+// the tenant who submits the request, unless an admin user submits the
+// request.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := rootURL(c)
+	if opts != nil {
+		query, err := opts.ToGroupListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return GroupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a particular firewall group based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(resourceURL(c, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToFirewallGroupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new firewall group.
+type CreateOpts struct {
+	// TenantID specifies a tenant to own the firewall group. The caller must
+	// have an admin role in order to set this. Otherwise, this field is left
+	// unset and the caller will be the owner.
+	TenantID    string `json:"tenant_id,omitempty"`
+	ProjectID   string `json:"project_id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	// IngressFirewallPolicyID is the policy applied to the traffic entering
+	// the ports of the group.
+	IngressFirewallPolicyID string `json:"ingress_firewall_policy_id,omitempty"`
+
+	// EgressFirewallPolicyID is the policy applied to the traffic leaving
+	// the ports of the group.
+	EgressFirewallPolicyID string `json:"egress_firewall_policy_id,omitempty"`
+
+	AdminStateUp *bool `json:"admin_state_up,omitempty"`
+
+	// Ports is the list of the IDs of the ports the group is associated
+	// with. Both router interfaces and VM ports are supported.
+	Ports []string `json:"ports,omitempty"`
+
+	Shared *bool `json:"shared,omitempty"`
+}
+
+// ToFirewallGroupCreateMap casts a CreateOpts struct to a map.
+func (opts CreateOpts) ToFirewallGroupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "firewall_group")
+}
+
+// Create accepts a CreateOpts struct and uses the values to create a new
+// firewall group.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToFirewallGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(rootURL(c), b, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToFirewallGroupUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating a firewall group.
+// These fields are all pointers so that unset fields will not cause the
+// existing Group attribute to be removed. Use RemoveIngressPolicy and
+// RemoveEgressPolicy to detach a policy from a group.
+type UpdateOpts struct {
+	Name                    *string `json:"name,omitempty"`
+	Description             *string `json:"description,omitempty"`
+	IngressFirewallPolicyID *string `json:"ingress_firewall_policy_id,omitempty"`
+	EgressFirewallPolicyID  *string `json:"egress_firewall_policy_id,omitempty"`
+	AdminStateUp            *bool   `json:"admin_state_up,omitempty"`
+
+	// Ports replaces the whole list of ports the group is associated with.
+	// An empty list disassociates the group from all its ports.
+	Ports *[]string `json:"ports,omitempty"`
+
+	Shared *bool `json:"shared,omitempty"`
+}
+
+// ToFirewallGroupUpdateMap casts an UpdateOpts struct to a map.
+func (opts UpdateOpts) ToFirewallGroupUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "firewall_group")
+}
+
+// Update allows firewall groups to be updated.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToFirewallGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// RemoveIngressPolicy detaches the ingress policy from a firewall group.
+func RemoveIngressPolicy(c *gophercloud.ServiceClient, id string) (r UpdateResult) {
+	return removePolicy(c, id, "ingress_firewall_policy_id")
+}
+
+// RemoveEgressPolicy detaches the egress policy from a firewall group.
+func RemoveEgressPolicy(c *gophercloud.ServiceClient, id string) (r UpdateResult) {
+	return removePolicy(c, id, "egress_firewall_policy_id")
+}
+
+func removePolicy(c *gophercloud.ServiceClient, id, attribute string) (r UpdateResult) {
+	b := map[string]interface{}{
+		"firewall_group": map[string]interface{}{
+			attribute: nil,
+		},
+	}
+	_, r.Err = c.Put(resourceURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will permanently delete a particular firewall group based on its
+// unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(resourceURL(c, id), nil)
+	return
+}
@@ -0,0 +1,11 @@
+package policies
+
+import "fmt"
+
+func err(str string) error {
+	return fmt.Errorf("%s", str)
+}
+
+var (
+	errBeforeAndAfter = err("Only one of InsertBefore and InsertAfter may be set")
+)
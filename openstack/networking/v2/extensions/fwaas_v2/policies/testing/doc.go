@@ -0,0 +1,2 @@
+// policies unit tests
+package testing
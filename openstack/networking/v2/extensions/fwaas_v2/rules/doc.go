@@ -0,0 +1,68 @@
+/*
+Package rules enables management and retrieval of FWaaS v2 Firewall Rules in
+the OpenStack Networking Service.
+
+Unlike FWaaS v1 rules, a v2 rule may be shared by several firewall policies,
+supports the reject action and can match on firewall groups.
+
+Example to List Rules
+
+	listOpts := rules.ListOpts{
+		Protocol: string(rules.ProtocolTCP),
+	}
+
+	allPages, err := rules.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allRules, err := rules.ExtractRules(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, rule := range allRules {
+		fmt.Printf("%+v\n", rule)
+	}
+
+Example to Create a Rule
+
+	createOpts := rules.CreateOpts{
+		Action:               rules.ActionAllow,
+		Protocol:             rules.ProtocolTCP,
+		IPVersion:            gophercloud.IPv4,
+		Description:          "ssh",
+		DestinationPort:      "22",
+		DestinationIPAddress: "192.168.1.0/24",
+	}
+
+	rule, err := rules.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Rule
+
+	ruleID := "f03bd950-6c56-4f5e-a307-45967078f507"
+	newPorts := "8000:8080"
+	newAction := rules.ActionReject
+
+	updateOpts := rules.UpdateOpts{
+		Action:          &newAction,
+		DestinationPort: &newPorts,
+	}
+
+	rule, err := rules.Update(networkClient, ruleID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Rule
+
+	ruleID := "f03bd950-6c56-4f5e-a307-45967078f507"
+	err := rules.Delete(networkClient, ruleID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package rules
@@ -0,0 +1,2 @@
+// addressscopes unit tests
+package testing
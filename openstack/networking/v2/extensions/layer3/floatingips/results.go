@@ -42,6 +42,9 @@ type FloatingIP struct {
 
 	// RouterID is the ID of the router used for this floating IP.
 	RouterID string `json:"router_id"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
 }
 
 type commonResult struct {
@@ -50,11 +53,15 @@ type commonResult struct {
 
 // Extract will extract a FloatingIP resource from a result.
 func (r commonResult) Extract() (*FloatingIP, error) {
-	var s struct {
-		FloatingIP *FloatingIP `json:"floatingip"`
-	}
+	var s FloatingIP
 	err := r.ExtractInto(&s)
-	return s.FloatingIP, err
+	return &s, err
+}
+
+// ExtractInto will extract a FloatingIP resource from a result into v, which
+// allows extensions to decode their own attributes alongside the FloatingIP.
+func (r commonResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoStructPtr(v, "floatingip")
 }
 
 // CreateResult represents the result of a create operation. Call its Extract
@@ -111,9 +118,14 @@ func (r FloatingIPPage) IsEmpty() (bool, error) {
 // struct, and extracts the elements into a slice of FloatingIP structs. In
 // other words, a generic collection is mapped into a relevant slice.
 func ExtractFloatingIPs(r pagination.Page) ([]FloatingIP, error) {
-	var s struct {
-		FloatingIPs []FloatingIP `json:"floatingips"`
-	}
-	err := (r.(FloatingIPPage)).ExtractInto(&s)
-	return s.FloatingIPs, err
+	var s []FloatingIP
+	err := ExtractFloatingIPsInto(r, &s)
+	return s, err
+}
+
+// ExtractFloatingIPsInto extracts the elements of a FloatingIPPage into v,
+// which allows extensions to decode their own attributes alongside each
+// FloatingIP.
+func ExtractFloatingIPsInto(r pagination.Page, v interface{}) error {
+	return r.(FloatingIPPage).Result.ExtractIntoSlicePtr(v, "floatingips")
 }
@@ -0,0 +1,106 @@
+/*
+Package portforwarding enables management and retrieval of port forwarding
+resources of floating IPs through the Neutron API. A port forwarding forwards
+the traffic of a single port, or range of ports, of a floating IP to an
+internal IP address, allowing a single floating IP to be shared by many
+services.
+
+Example to list all port forwardings of a floating IP
+
+	floatingIPID := "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+
+	listOpts := portforwarding.ListOpts{
+		Protocol: portforwarding.ProtocolTCP,
+	}
+
+	allPages, err := portforwarding.List(client, floatingIPID, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allPFs, err := portforwarding.ExtractPortForwardings(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pf := range allPFs {
+		fmt.Printf("%+v\n", pf)
+	}
+
+Example to Get a Port Forwarding with a certain ID
+
+	pf, err := portforwarding.Get(client, floatingIPID, pfID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Port Forwarding for a floating IP
+
+	createOpts := portforwarding.CreateOpts{
+		Protocol:          portforwarding.ProtocolTCP,
+		InternalPort:      22,
+		InternalIPAddress: "10.0.0.24",
+		ExternalPort:      2230,
+		InternalPortID:    "e0a1e5d2-7bb8-4a81-9a5f-e3bd8e80d1a4",
+	}
+
+	pf, err := portforwarding.Create(client, floatingIPID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Port Forwarding for a range of ports
+
+	createOpts := portforwarding.CreateOpts{
+		Protocol:          portforwarding.ProtocolUDP,
+		InternalPortRange: "5000:5009",
+		ExternalPortRange: "15000:15009",
+		InternalIPAddress: "10.0.0.24",
+		InternalPortID:    "e0a1e5d2-7bb8-4a81-9a5f-e3bd8e80d1a4",
+	}
+
+	pf, err := portforwarding.Create(client, floatingIPID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Port Forwarding
+
+	updateOpts := portforwarding.UpdateOpts{
+		Protocol:     portforwarding.ProtocolTCP,
+		InternalPort: 30,
+	}
+
+	pf, err := portforwarding.Update(client, floatingIPID, pfID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Port forwarding
+
+	err := portforwarding.Delete(client, floatingIPID, pfID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to forward the first free external port to an internal service
+
+	port, err := portforwarding.FindFreeExternalPort(client, floatingIPID, portforwarding.ProtocolTCP, 20000, 29999)
+	if err != nil {
+		panic(err)
+	}
+
+	createOpts := portforwarding.CreateOpts{
+		Protocol:          portforwarding.ProtocolTCP,
+		InternalPort:      8080,
+		InternalIPAddress: "10.0.0.24",
+		ExternalPort:      port,
+		InternalPortID:    "e0a1e5d2-7bb8-4a81-9a5f-e3bd8e80d1a4",
+	}
+
+	pf, err := portforwarding.Create(client, floatingIPID, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package portforwarding
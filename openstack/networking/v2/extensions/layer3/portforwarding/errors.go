@@ -0,0 +1,21 @@
+package portforwarding
+
+import (
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrNoFreeExternalPort is returned by FindFreeExternalPort when every
+// external port of the given range is already forwarded.
+type ErrNoFreeExternalPort struct {
+	gophercloud.BaseError
+	FloatingIPID string
+	Protocol     string
+	First        int
+	Last         int
+}
+
+func (e ErrNoFreeExternalPort) Error() string {
+	return fmt.Sprintf("No free %s port between %d and %d on floating IP %s", e.Protocol, e.First, e.Last, e.FloatingIPID)
+}
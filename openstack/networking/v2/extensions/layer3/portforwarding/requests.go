@@ -0,0 +1,157 @@
+package portforwarding
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// Protocols supported by port forwardings.
+const (
+	ProtocolTCP     = "tcp"
+	ProtocolUDP     = "udp"
+	ProtocolUDPLite = "udplite"
+	ProtocolDCCP    = "dccp"
+	ProtocolSCTP    = "sctp"
+	ProtocolICMP    = "icmp"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToPortForwardingListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the port forwarding attributes you want to see returned. SortKey allows you
+// to sort by a particular port forwarding attribute. SortDir sets the
+// direction, and is either `asc' or `desc'. Marker and Limit are used for
+// pagination.
+type ListOpts struct {
+	ID                string `q:"id"`
+	InternalPortID    string `q:"internal_port_id"`
+	ExternalPort      int    `q:"external_port"`
+	ExternalPortRange string `q:"external_port_range"`
+	Protocol          string `q:"protocol"`
+	Description       string `q:"description"`
+	Limit             int    `q:"limit"`
+	Marker            string `q:"marker"`
+	SortKey           string `q:"sort_key"`
+	SortDir           string `q:"sort_dir"`
+}
+
+// ToPortForwardingListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToPortForwardingListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// port forwardings of a floating IP. It accepts a ListOpts struct, which
+// allows you to filter and sort the returned collection for greater
+// efficiency.
+func List(c *gophercloud.ServiceClient, floatingIPID string, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c, floatingIPID)
+	if opts != nil {
+		query, err := opts.ToPortForwardingListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return PortForwardingPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a particular port forwarding of a floating IP based on its
+// unique ID.
+func Get(c *gophercloud.ServiceClient, floatingIPID, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, floatingIPID, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToPortForwardingCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts contains all the values needed to create a new port forwarding.
+// Either InternalPort and ExternalPort, or InternalPortRange and
+// ExternalPortRange in the "first:last" form must be given.
+type CreateOpts struct {
+	InternalIPAddress string `json:"internal_ip_address" required:"true"`
+	InternalPortID    string `json:"internal_port_id" required:"true"`
+	Protocol          string `json:"protocol" required:"true"`
+	InternalPort      int    `json:"internal_port,omitempty"`
+	ExternalPort      int    `json:"external_port,omitempty"`
+	InternalPortRange string `json:"internal_port_range,omitempty"`
+	ExternalPortRange string `json:"external_port_range,omitempty"`
+	Description       string `json:"description,omitempty"`
+}
+
+// ToPortForwardingCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToPortForwardingCreateMap() (map[string]interface{}, error) {
+	if opts.ExternalPort == 0 && opts.ExternalPortRange == "" {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "portforwarding.CreateOpts.ExternalPort"
+		return nil, err
+	}
+	return gophercloud.BuildRequestBody(opts, "port_forwarding")
+}
+
+// Create accepts a CreateOpts struct and uses the values provided to create a
+// new port forwarding on the given floating IP.
+func Create(c *gophercloud.ServiceClient, floatingIPID string, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToPortForwardingCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c, floatingIPID), b, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToPortForwardingUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts contains the values used when updating a port forwarding.
+type UpdateOpts struct {
+	InternalIPAddress string  `json:"internal_ip_address,omitempty"`
+	InternalPortID    string  `json:"internal_port_id,omitempty"`
+	Protocol          string  `json:"protocol,omitempty"`
+	InternalPort      int     `json:"internal_port,omitempty"`
+	ExternalPort      int     `json:"external_port,omitempty"`
+	InternalPortRange string  `json:"internal_port_range,omitempty"`
+	ExternalPortRange string  `json:"external_port_range,omitempty"`
+	Description       *string `json:"description,omitempty"`
+}
+
+// ToPortForwardingUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToPortForwardingUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "port_forwarding")
+}
+
+// Update allows port forwarding resources to be updated.
+func Update(c *gophercloud.ServiceClient, floatingIPID, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToPortForwardingUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updateURL(c, floatingIPID, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete will permanently delete a particular port forwarding of a floating
+// IP.
+func Delete(c *gophercloud.ServiceClient, floatingIPID, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, floatingIPID, id), nil)
+	return
+}
@@ -0,0 +1,116 @@
+package portforwarding
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// PortForwarding represents a port forwarding of a floating IP, which
+// forwards traffic from a port or range of ports of the floating IP to an
+// internal IP address.
+type PortForwarding struct {
+	// The ID of the port forwarding.
+	ID string `json:"id"`
+
+	// The ID of the Neutron port associated with the port forwarding.
+	InternalPortID string `json:"internal_port_id"`
+
+	// The TCP/UDP/other protocol port number of the port forwarding's
+	// floating IP address. It is zero when ExternalPortRange is used.
+	ExternalPort int `json:"external_port"`
+
+	// The TCP/UDP/other protocol port number of the Neutron port fixed IP
+	// address associated to the port forwarding. It is zero when
+	// InternalPortRange is used.
+	InternalPort int `json:"internal_port"`
+
+	// The range of external ports in the "first:last" form.
+	ExternalPortRange string `json:"external_port_range"`
+
+	// The range of internal ports in the "first:last" form.
+	InternalPortRange string `json:"internal_port_range"`
+
+	// The IP protocol used in the port forwarding.
+	Protocol string `json:"protocol"`
+
+	// The fixed IPv4 address of the Neutron port associated to the port
+	// forwarding.
+	InternalIPAddress string `json:"internal_ip_address"`
+
+	// A text describing the rule, which helps users to manage/find easily.
+	Description string `json:"description"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract will extract a PortForwarding resource from a result.
+func (r commonResult) Extract() (*PortForwarding, error) {
+	var s struct {
+		PortForwarding *PortForwarding `json:"port_forwarding"`
+	}
+	err := r.ExtractInto(&s)
+	return s.PortForwarding, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a PortForwarding.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a PortForwarding.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a PortForwarding.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// PortForwardingPage is the page returned by a pager when traversing over a
+// collection of port forwardings.
+type PortForwardingPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of port forwardings has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (r PortForwardingPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"port_forwardings_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a PortForwardingPage struct is empty.
+func (r PortForwardingPage) IsEmpty() (bool, error) {
+	is, err := ExtractPortForwardings(r)
+	return len(is) == 0, err
+}
+
+// ExtractPortForwardings accepts a Page struct, specifically a
+// PortForwardingPage struct, and extracts the elements into a slice of
+// PortForwarding structs.
+func ExtractPortForwardings(r pagination.Page) ([]PortForwarding, error) {
+	var s struct {
+		PortForwardings []PortForwarding `json:"port_forwardings"`
+	}
+	err := (r.(PortForwardingPage)).ExtractInto(&s)
+	return s.PortForwardings, err
+}
@@ -0,0 +1,2 @@
+// port forwarding unit tests
+package testing
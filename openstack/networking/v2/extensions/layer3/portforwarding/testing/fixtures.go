@@ -0,0 +1,100 @@
+package testing
+
+import "github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+
+const ListResponse = `
+{
+    "port_forwardings": [
+        {
+            "id": "725ade3c-9760-4880-8080-8fc2dbab9acc",
+            "internal_port_id": "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+            "internal_ip_address": "10.0.0.5",
+            "internal_port": 22,
+            "external_port": 2230,
+            "protocol": "tcp",
+            "description": "ssh"
+        },
+        {
+            "id": "da554833-0d8f-4c13-b70a-df0fcf3c2b2e",
+            "internal_port_id": "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+            "internal_ip_address": "10.0.0.5",
+            "internal_port": null,
+            "external_port": null,
+            "internal_port_range": "8000:8009",
+            "external_port_range": "2231:2240",
+            "protocol": "tcp",
+            "description": ""
+        }
+    ]
+}
+`
+
+const CreateRequest = `
+{
+    "port_forwarding": {
+        "protocol": "tcp",
+        "internal_ip_address": "10.0.0.5",
+        "internal_port": 22,
+        "internal_port_id": "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+        "external_port": 2230,
+        "description": "ssh"
+    }
+}
+`
+
+const PortForwardingResponse = `
+{
+    "port_forwarding": {
+        "id": "725ade3c-9760-4880-8080-8fc2dbab9acc",
+        "internal_port_id": "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+        "internal_ip_address": "10.0.0.5",
+        "internal_port": 22,
+        "external_port": 2230,
+        "protocol": "tcp",
+        "description": "ssh"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "port_forwarding": {
+        "protocol": "udp",
+        "internal_port": 37,
+        "description": ""
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "port_forwarding": {
+        "id": "725ade3c-9760-4880-8080-8fc2dbab9acc",
+        "internal_port_id": "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+        "internal_ip_address": "10.0.0.5",
+        "internal_port": 37,
+        "external_port": 2230,
+        "protocol": "udp",
+        "description": ""
+    }
+}
+`
+
+var PortForwarding1 = portforwarding.PortForwarding{
+	ID:                "725ade3c-9760-4880-8080-8fc2dbab9acc",
+	InternalPortID:    "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+	InternalIPAddress: "10.0.0.5",
+	InternalPort:      22,
+	ExternalPort:      2230,
+	Protocol:          "tcp",
+	Description:       "ssh",
+}
+
+var PortForwarding2 = portforwarding.PortForwarding{
+	ID:                "da554833-0d8f-4c13-b70a-df0fcf3c2b2e",
+	InternalPortID:    "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+	InternalIPAddress: "10.0.0.5",
+	InternalPortRange: "8000:8009",
+	ExternalPortRange: "2231:2240",
+	Protocol:          "tcp",
+}
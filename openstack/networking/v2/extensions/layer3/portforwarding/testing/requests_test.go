@@ -0,0 +1,203 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/layer3/portforwarding"
+	"github.com/chjlangzi/gophercloud/pagination"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+const floatingIPID = "2f245a7b-796b-4f26-9cf9-9e82d248fda7"
+
+func handleList(t *testing.T) {
+	th.Mux.HandleFunc("/v2.0/floatingips/"+floatingIPID+"/port_forwardings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"protocol": "tcp"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleList(t)
+
+	count := 0
+
+	err := portforwarding.List(fake.ServiceClient(), floatingIPID, portforwarding.ListOpts{Protocol: portforwarding.ProtocolTCP}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := portforwarding.ExtractPortForwardings(page)
+		if err != nil {
+			t.Errorf("Failed to extract port forwardings: %v", err)
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, []portforwarding.PortForwarding{PortForwarding1, PortForwarding2}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/"+floatingIPID+"/port_forwardings", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, PortForwardingResponse)
+	})
+
+	options := portforwarding.CreateOpts{
+		Protocol:          portforwarding.ProtocolTCP,
+		InternalIPAddress: "10.0.0.5",
+		InternalPort:      22,
+		InternalPortID:    "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+		ExternalPort:      2230,
+		Description:       "ssh",
+	}
+
+	pf, err := portforwarding.Create(fake.ServiceClient(), floatingIPID, options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &PortForwarding1, pf)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := portforwarding.Create(fake.ServiceClient(), floatingIPID, portforwarding.CreateOpts{
+		Protocol:          portforwarding.ProtocolTCP,
+		InternalIPAddress: "10.0.0.5",
+		InternalPort:      22,
+		InternalPortID:    "1238be08-a2a8-4b8d-addf-fb5e2250e480",
+	})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/"+floatingIPID+"/port_forwardings/725ade3c-9760-4880-8080-8fc2dbab9acc", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, PortForwardingResponse)
+	})
+
+	pf, err := portforwarding.Get(fake.ServiceClient(), floatingIPID, "725ade3c-9760-4880-8080-8fc2dbab9acc").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &PortForwarding1, pf)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/"+floatingIPID+"/port_forwardings/725ade3c-9760-4880-8080-8fc2dbab9acc", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateResponse)
+	})
+
+	description := ""
+	updateOpts := portforwarding.UpdateOpts{
+		Protocol:     portforwarding.ProtocolUDP,
+		InternalPort: 37,
+		Description:  &description,
+	}
+
+	pf, err := portforwarding.Update(fake.ServiceClient(), floatingIPID, "725ade3c-9760-4880-8080-8fc2dbab9acc", updateOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "udp", pf.Protocol)
+	th.AssertEquals(t, 37, pf.InternalPort)
+	th.AssertEquals(t, "", pf.Description)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/floatingips/"+floatingIPID+"/port_forwardings/725ade3c-9760-4880-8080-8fc2dbab9acc", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := portforwarding.Delete(fake.ServiceClient(), floatingIPID, "725ade3c-9760-4880-8080-8fc2dbab9acc")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestFindFreeExternalPort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	handleList(t)
+
+	port, err := portforwarding.FindFreeExternalPort(fake.ServiceClient(), floatingIPID, portforwarding.ProtocolTCP, 2230, 2300)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2241, port)
+
+	port, err = portforwarding.FindFreeExternalPort(fake.ServiceClient(), floatingIPID, portforwarding.ProtocolTCP, 2000, 2300)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2000, port)
+
+	_, err = portforwarding.FindFreeExternalPort(fake.ServiceClient(), floatingIPID, portforwarding.ProtocolTCP, 2230, 2240)
+	if _, ok := err.(portforwarding.ErrNoFreeExternalPort); !ok {
+		t.Fatalf("Expected ErrNoFreeExternalPort, got %v", err)
+	}
+}
+
+func TestParsePortRange(t *testing.T) {
+	first, last, err := portforwarding.ParsePortRange("100:200")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 100, first)
+	th.AssertEquals(t, 200, last)
+
+	first, last, err = portforwarding.ParsePortRange("22")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 22, first)
+	th.AssertEquals(t, 22, last)
+
+	_, _, err = portforwarding.ParsePortRange("200:100")
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+
+	_, _, err = portforwarding.ParsePortRange("a:b")
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
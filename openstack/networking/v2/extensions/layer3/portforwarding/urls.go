@@ -0,0 +1,36 @@
+package portforwarding
+
+import "github.com/chjlangzi/gophercloud"
+
+const (
+	rootPath     = "floatingips"
+	resourcePath = "port_forwardings"
+)
+
+func rootURL(c *gophercloud.ServiceClient, floatingIPID string) string {
+	return c.ServiceURL(rootPath, floatingIPID, resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, floatingIPID, id string) string {
+	return c.ServiceURL(rootPath, floatingIPID, resourcePath, id)
+}
+
+func listURL(c *gophercloud.ServiceClient, floatingIPID string) string {
+	return rootURL(c, floatingIPID)
+}
+
+func createURL(c *gophercloud.ServiceClient, floatingIPID string) string {
+	return rootURL(c, floatingIPID)
+}
+
+func getURL(c *gophercloud.ServiceClient, floatingIPID, id string) string {
+	return resourceURL(c, floatingIPID, id)
+}
+
+func updateURL(c *gophercloud.ServiceClient, floatingIPID, id string) string {
+	return resourceURL(c, floatingIPID, id)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, floatingIPID, id string) string {
+	return resourceURL(c, floatingIPID, id)
+}
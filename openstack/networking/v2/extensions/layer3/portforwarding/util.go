@@ -0,0 +1,84 @@
+package portforwarding
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ParsePortRange parses a port range in the "first:last" form. A single
+// port number is accepted as a range of one port.
+func ParsePortRange(r string) (first, last int, err error) {
+	parts := strings.SplitN(r, ":", 2)
+
+	first, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid port range %q: %s", r, err)
+	}
+
+	last = first
+	if len(parts) == 2 {
+		last, err = strconv.Atoi(parts[1])
+		if err != nil {
+			return 0, 0, fmt.Errorf("Invalid port range %q: %s", r, err)
+		}
+	}
+
+	if first > last {
+		return 0, 0, fmt.Errorf("Invalid port range %q: first port is greater than the last one", r)
+	}
+
+	return first, last, nil
+}
+
+// FindFreeExternalPort returns the lowest external port between first and
+// last, inclusive, which is not yet forwarded for the given protocol on the
+// floating IP. Both single ports and port ranges of the existing port
+// forwardings are taken into account. It returns an ErrNoFreeExternalPort if
+// every port is in use.
+func FindFreeExternalPort(c *gophercloud.ServiceClient, floatingIPID, protocol string, first, last int) (int, error) {
+	allPages, err := List(c, floatingIPID, ListOpts{Protocol: protocol}).AllPages()
+	if err != nil {
+		return 0, err
+	}
+
+	allPFs, err := ExtractPortForwardings(allPages)
+	if err != nil {
+		return 0, err
+	}
+
+	used := make(map[int]bool)
+	for _, pf := range allPFs {
+		if pf.Protocol != protocol {
+			continue
+		}
+
+		if pf.ExternalPortRange == "" {
+			used[pf.ExternalPort] = true
+			continue
+		}
+
+		rFirst, rLast, err := ParsePortRange(pf.ExternalPortRange)
+		if err != nil {
+			return 0, err
+		}
+		for port := rFirst; port <= rLast; port++ {
+			used[port] = true
+		}
+	}
+
+	for port := first; port <= last; port++ {
+		if !used[port] {
+			return port, nil
+		}
+	}
+
+	return 0, ErrNoFreeExternalPort{
+		FloatingIPID: floatingIPID,
+		Protocol:     protocol,
+		First:        first,
+		Last:         last,
+	}
+}
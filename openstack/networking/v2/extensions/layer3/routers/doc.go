@@ -56,6 +56,58 @@ Example to Update a Router
 		panic(err)
 	}
 
+Example to Create a highly available Router
+
+	iTrue := true
+	createOpts := routers.CreateOpts{
+		Name: "ha_router",
+		HA:   &iTrue,
+		GatewayInfo: &routers.GatewayInfo{
+			NetworkID:  "8ca37218-28ff-41cb-9b10-039601ea7e6b",
+			EnableSNAT: &iTrue,
+			ExternalFixedIPs: []routers.ExternalFixedIP{
+				{SubnetID: "ab561bc4-1a8e-48f2-9fbd-376fcb1a1def"},
+			},
+		},
+	}
+
+	router, err := routers.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Add Routes to a Router
+
+	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+	opts := routers.ExtraRoutesOpts{
+		Routes: []routers.Route{{
+			DestinationCIDR: "40.0.2.0/24",
+			NextHop:         "10.1.0.11",
+		}},
+	}
+
+	router, err := routers.AddExtraRoutes(networkClient, routerID, opts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Remove Routes from a Router
+
+	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+
+	opts := routers.ExtraRoutesOpts{
+		Routes: []routers.Route{{
+			DestinationCIDR: "40.0.2.0/24",
+			NextHop:         "10.1.0.11",
+		}},
+	}
+
+	router, err := routers.RemoveExtraRoutes(networkClient, routerID, opts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Remove all Routes from a Router
 
 	routerID := "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
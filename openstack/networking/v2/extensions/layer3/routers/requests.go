@@ -22,6 +22,10 @@ type ListOpts struct {
 	Marker       string `q:"marker"`
 	SortKey      string `q:"sort_key"`
 	SortDir      string `q:"sort_dir"`
+	Tags         string `q:"tags"`
+	TagsAny      string `q:"tags-any"`
+	NotTags      string `q:"not-tags"`
+	NotTagsAny   string `q:"not-tags-any"`
 }
 
 // List returns a Pager which allows you to iterate over a collection of
@@ -53,6 +57,8 @@ type CreateOpts struct {
 	Name                  string       `json:"name,omitempty"`
 	AdminStateUp          *bool        `json:"admin_state_up,omitempty"`
 	Distributed           *bool        `json:"distributed,omitempty"`
+	HA                    *bool        `json:"ha,omitempty"`
+	FlavorID              string       `json:"flavor_id,omitempty"`
 	TenantID              string       `json:"tenant_id,omitempty"`
 	ProjectID             string       `json:"project_id,omitempty"`
 	GatewayInfo           *GatewayInfo `json:"external_gateway_info,omitempty"`
@@ -95,17 +101,31 @@ type UpdateOptsBuilder interface {
 }
 
 // UpdateOpts contains the values used when updating a router.
+//
+// Routes replaces the whole route table of the router. It is only sent when
+// it is non-nil, so leave it unset to keep the existing routes and pass an
+// empty slice to remove all of them.
 type UpdateOpts struct {
 	Name         string       `json:"name,omitempty"`
 	AdminStateUp *bool        `json:"admin_state_up,omitempty"`
 	Distributed  *bool        `json:"distributed,omitempty"`
+	HA           *bool        `json:"ha,omitempty"`
 	GatewayInfo  *GatewayInfo `json:"external_gateway_info,omitempty"`
 	Routes       []Route      `json:"routes"`
 }
 
 // ToRouterUpdateMap builds an update body based on UpdateOpts.
 func (opts UpdateOpts) ToRouterUpdateMap() (map[string]interface{}, error) {
-	return gophercloud.BuildRequestBody(opts, "router")
+	b, err := gophercloud.BuildRequestBody(opts, "router")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Routes == nil {
+		delete(b["router"].(map[string]interface{}), "routes")
+	}
+
+	return b, nil
 }
 
 // Update allows routers to be updated. You can update the name, administrative
@@ -224,3 +244,54 @@ func RemoveInterface(c *gophercloud.ServiceClient, id string, opts RemoveInterfa
 	})
 	return
 }
+
+// ExtraRoutesOptsBuilder allows extensions to add additional parameters to
+// the AddExtraRoutes and RemoveExtraRoutes requests.
+type ExtraRoutesOptsBuilder interface {
+	ToRouterExtraRoutesMap() (map[string]interface{}, error)
+}
+
+// ExtraRoutesOpts represents the routes to add to or remove from a router.
+type ExtraRoutesOpts struct {
+	Routes []Route `json:"routes" required:"true"`
+}
+
+// ToRouterExtraRoutesMap builds a request body based on ExtraRoutesOpts.
+func (opts ExtraRoutesOpts) ToRouterExtraRoutesMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "router")
+}
+
+// AddExtraRoutes atomically adds the given routes to the route table of a
+// router, leaving the routes already present untouched. Unlike setting
+// UpdateOpts.Routes, this is safe when several clients manage the routes of
+// the same router. Adding a route which is already present is a no-op.
+//
+// This requires the extraroute-atomic extension.
+func AddExtraRoutes(c *gophercloud.ServiceClient, id string, opts ExtraRoutesOptsBuilder) (r ExtraRoutesResult) {
+	b, err := opts.ToRouterExtraRoutesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(addExtraRoutesURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// RemoveExtraRoutes atomically removes the given routes from the route table
+// of a router, leaving the other routes untouched. Removing a route which is
+// not present is a no-op.
+//
+// This requires the extraroute-atomic extension.
+func RemoveExtraRoutes(c *gophercloud.ServiceClient, id string, opts ExtraRoutesOptsBuilder) (r ExtraRoutesResult) {
+	b, err := opts.ToRouterExtraRoutesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(removeExtraRoutesURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
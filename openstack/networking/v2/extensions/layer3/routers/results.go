@@ -16,7 +16,7 @@ type GatewayInfo struct {
 // ExternalFixedIP is the IP address and subnet ID of the external gateway of a
 // router.
 type ExternalFixedIP struct {
-	IPAddress string `json:"ip_address"`
+	IPAddress string `json:"ip_address,omitempty"`
 	SubnetID  string `json:"subnet_id"`
 }
 
@@ -47,6 +47,13 @@ type Router struct {
 	// Distributed is whether router is disitrubted or not.
 	Distributed bool `json:"distributed"`
 
+	// HA is whether the router is highly available, i.e. hosted by several
+	// L3 agents of which one is active at a time.
+	HA bool `json:"ha"`
+
+	// FlavorID is the ID of the flavor of the router.
+	FlavorID string `json:"flavor_id"`
+
 	// Name is the human readable name for the router. It does not have to be
 	// unique.
 	Name string `json:"name"`
@@ -67,6 +74,9 @@ type Router struct {
 	// Availability zone hints groups network nodes that run services like DHCP, L3, FW, and others.
 	// Used to make network resources highly available.
 	AvailabilityZoneHints []string `json:"availability_zone_hints"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
 }
 
 // RouterPage is the page returned by a pager when traversing over a
@@ -137,6 +147,13 @@ type UpdateResult struct {
 	commonResult
 }
 
+// ExtraRoutesResult represents the result of an AddExtraRoutes or
+// RemoveExtraRoutes operation. Call its Extract method to interpret it as a
+// Router with its resulting routes.
+type ExtraRoutesResult struct {
+	commonResult
+}
+
 // DeleteResult represents the result of a delete operation. Call its ExtractErr
 // method to determine if the request succeeded or failed.
 type DeleteResult struct {
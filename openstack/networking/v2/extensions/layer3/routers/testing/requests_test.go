@@ -369,6 +369,62 @@ func TestAllRoutesRemoved(t *testing.T) {
 	th.AssertDeepEquals(t, n.Routes, []routers.Route{})
 }
 
+func TestUpdateHAKeepsRoutes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "router": {
+        "ha": true
+    }
+}
+			`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "router": {
+        "status": "ACTIVE",
+        "name": "name",
+        "admin_state_up": true,
+        "tenant_id": "6b96ff0cb17a4b859e1e575d221683d3",
+        "ha": true,
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+        "routes": [
+            {
+                "nexthop": "10.1.0.10",
+                "destination": "40.0.1.0/24"
+            }
+        ]
+    }
+}
+		`)
+	})
+
+	iTrue := true
+	options := routers.UpdateOpts{HA: &iTrue}
+
+	b, err := options.ToRouterUpdateMap()
+	th.AssertNoErr(t, err)
+	if _, ok := b["router"].(map[string]interface{})["routes"]; ok {
+		t.Fatalf("Expected routes to be left out of the request body, got %v", b)
+	}
+
+	n, err := routers.Update(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, true, n.HA)
+	th.AssertDeepEquals(t, []routers.Route{{DestinationCIDR: "40.0.1.0/24", NextHop: "10.1.0.10"}}, n.Routes)
+}
+
 func TestDelete(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -469,3 +525,190 @@ func TestRemoveInterface(t *testing.T) {
 	th.AssertEquals(t, "3f990102-4485-4df1-97a0-2c35bdb85b31", res.PortID)
 	th.AssertEquals(t, "9a83fa11-8da5-436e-9afe-3d3ac5ce7770", res.ID)
 }
+
+func TestCreateHAWithExternalFixedIPs(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+   "router":{
+      "name": "ha_router",
+      "ha": true,
+      "flavor_id": "4f6b6a2d-a1a0-4b1e-93a6-3b0e4f3c2d7e",
+      "external_gateway_info":{
+         "enable_snat": true,
+         "network_id":"8ca37218-28ff-41cb-9b10-039601ea7e6b",
+         "external_fixed_ips": [
+            {"subnet_id": "ab561bc4-1a8e-48f2-9fbd-376fcb1a1def"}
+         ]
+      }
+   }
+}
+			`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, `
+{
+    "router": {
+        "status": "ACTIVE",
+        "external_gateway_info": {
+            "network_id": "8ca37218-28ff-41cb-9b10-039601ea7e6b",
+            "enable_snat": true,
+            "external_fixed_ips": [
+                {"ip_address": "192.0.2.17", "subnet_id": "ab561bc4-1a8e-48f2-9fbd-376fcb1a1def"}
+            ]
+        },
+        "name": "ha_router",
+        "admin_state_up": true,
+        "tenant_id": "6b96ff0cb17a4b859e1e575d221683d3",
+        "distributed": false,
+        "ha": true,
+        "flavor_id": "4f6b6a2d-a1a0-4b1e-93a6-3b0e4f3c2d7e",
+        "id": "8604a0de-7f6b-409a-a47c-a1cc7bc77b2e"
+    }
+}
+		`)
+	})
+
+	iTrue := true
+	options := routers.CreateOpts{
+		Name:     "ha_router",
+		HA:       &iTrue,
+		FlavorID: "4f6b6a2d-a1a0-4b1e-93a6-3b0e4f3c2d7e",
+		GatewayInfo: &routers.GatewayInfo{
+			NetworkID:  "8ca37218-28ff-41cb-9b10-039601ea7e6b",
+			EnableSNAT: &iTrue,
+			ExternalFixedIPs: []routers.ExternalFixedIP{
+				{SubnetID: "ab561bc4-1a8e-48f2-9fbd-376fcb1a1def"},
+			},
+		},
+	}
+	r, err := routers.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, true, r.HA)
+	th.AssertEquals(t, "4f6b6a2d-a1a0-4b1e-93a6-3b0e4f3c2d7e", r.FlavorID)
+	th.AssertEquals(t, true, *r.GatewayInfo.EnableSNAT)
+	th.AssertDeepEquals(t, []routers.ExternalFixedIP{
+		{IPAddress: "192.0.2.17", SubnetID: "ab561bc4-1a8e-48f2-9fbd-376fcb1a1def"},
+	}, r.GatewayInfo.ExternalFixedIPs)
+}
+
+func TestAddExtraRoutes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/4e8e5957-649f-477b-9e5b-f1f75b21c03c/add_extraroutes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "router": {
+        "routes": [
+            {"destination": "10.0.3.0/24", "nexthop": "10.0.0.13"},
+            {"destination": "10.0.4.0/24", "nexthop": "10.0.0.14"}
+        ]
+    }
+}
+			`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "router": {
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+        "name": "router1",
+        "routes": [
+            {"destination": "10.0.1.0/24", "nexthop": "10.0.0.11"},
+            {"destination": "10.0.3.0/24", "nexthop": "10.0.0.13"},
+            {"destination": "10.0.4.0/24", "nexthop": "10.0.0.14"}
+        ]
+    }
+}
+		`)
+	})
+
+	opts := routers.ExtraRoutesOpts{
+		Routes: []routers.Route{
+			{DestinationCIDR: "10.0.3.0/24", NextHop: "10.0.0.13"},
+			{DestinationCIDR: "10.0.4.0/24", NextHop: "10.0.0.14"},
+		},
+	}
+
+	r, err := routers.AddExtraRoutes(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []routers.Route{
+		{DestinationCIDR: "10.0.1.0/24", NextHop: "10.0.0.11"},
+		{DestinationCIDR: "10.0.3.0/24", NextHop: "10.0.0.13"},
+		{DestinationCIDR: "10.0.4.0/24", NextHop: "10.0.0.14"},
+	}, r.Routes)
+}
+
+func TestRemoveExtraRoutes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/routers/4e8e5957-649f-477b-9e5b-f1f75b21c03c/remove_extraroutes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+    "router": {
+        "routes": [
+            {"destination": "10.0.3.0/24", "nexthop": "10.0.0.13"}
+        ]
+    }
+}
+			`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `
+{
+    "router": {
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+        "name": "router1",
+        "routes": [
+            {"destination": "10.0.1.0/24", "nexthop": "10.0.0.11"}
+        ]
+    }
+}
+		`)
+	})
+
+	opts := routers.ExtraRoutesOpts{
+		Routes: []routers.Route{
+			{DestinationCIDR: "10.0.3.0/24", NextHop: "10.0.0.13"},
+		},
+	}
+
+	r, err := routers.RemoveExtraRoutes(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, []routers.Route{
+		{DestinationCIDR: "10.0.1.0/24", NextHop: "10.0.0.11"},
+	}, r.Routes)
+}
+
+func TestExtraRoutesRequiredOpts(t *testing.T) {
+	res := routers.AddExtraRoutes(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", routers.ExtraRoutesOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
@@ -19,3 +19,11 @@ func addInterfaceURL(c *gophercloud.ServiceClient, id string) string {
 func removeInterfaceURL(c *gophercloud.ServiceClient, id string) string {
 	return c.ServiceURL(resourcePath, id, "remove_router_interface")
 }
+
+func addExtraRoutesURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "add_extraroutes")
+}
+
+func removeExtraRoutesURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "remove_extraroutes")
+}
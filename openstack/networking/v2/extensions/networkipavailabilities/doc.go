@@ -0,0 +1,35 @@
+/*
+Package networkipavailabilities provides the ability to retrieve the IP
+usage of networks and of their subnets through the Neutron API.
+
+The IP counters are returned as *big.Int values, since the size of IPv6
+subnets does not fit into the native integer types.
+
+Example of Listing NetworkIPAvailabilities
+
+	allPages, err := networkipavailabilities.List(networkClient, networkipavailabilities.ListOpts{}).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allAvailabilities, err := networkipavailabilities.ExtractNetworkIPAvailabilities(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, availability := range allAvailabilities {
+		fmt.Printf("%s: %s of %s used\n", availability.NetworkName, availability.UsedIPs, availability.TotalIPs)
+	}
+
+Example of Getting a single NetworkIPAvailability
+
+	availability, err := networkipavailabilities.Get(networkClient, "cf11ab78-2302-49fa-870f-851a08c7afb8").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, subnet := range availability.SubnetIPAvailabilities {
+		fmt.Printf("%s: %s free\n", subnet.CIDR, subnet.FreeIPs())
+	}
+*/
+package networkipavailabilities
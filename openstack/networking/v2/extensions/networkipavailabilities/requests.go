@@ -0,0 +1,60 @@
+package networkipavailabilities
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToNetworkIPAvailabilityListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of the network IP availabilities through the
+// API.
+type ListOpts struct {
+	// NetworkName allows to filter on the network name.
+	NetworkName string `q:"network_name"`
+
+	// NetworkID allows to filter on the network ID.
+	NetworkID string `q:"network_id"`
+
+	// IPVersion allows to filter on the IP version of the subnets.
+	IPVersion int `q:"ip_version"`
+
+	// ProjectID allows to filter on the Identity project field.
+	ProjectID string `q:"project_id"`
+
+	// TenantID allows to filter on the Identity project field.
+	TenantID string `q:"tenant_id"`
+}
+
+// ToNetworkIPAvailabilityListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToNetworkIPAvailabilityListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// network IP availabilities. It accepts a ListOpts struct, which allows you
+// to filter the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToNetworkIPAvailabilityListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return NetworkIPAvailabilityPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get retrieves the IP availability of a specific network based on its ID.
+func Get(c *gophercloud.ServiceClient, networkID string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, networkID), &r.Body, nil)
+	return
+}
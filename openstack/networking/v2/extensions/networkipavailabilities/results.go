@@ -0,0 +1,185 @@
+package networkipavailabilities
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a NetworkIPAvailability.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a
+// NetworkIPAvailability.
+func (r GetResult) Extract() (*NetworkIPAvailability, error) {
+	var s struct {
+		NetworkIPAvailability *NetworkIPAvailability `json:"network_ip_availability"`
+	}
+	err := r.ExtractInto(&s)
+	return s.NetworkIPAvailability, err
+}
+
+// NetworkIPAvailability represents the IP usage of a network and of each of
+// its subnets.
+//
+// Counters are exposed as *big.Int because IPv6 subnets routinely exceed the
+// range of the native integer types. Note that response bodies are decoded
+// into float64 values first, so counters above 2^53 are approximations.
+type NetworkIPAvailability struct {
+	// NetworkID is the ID of the network.
+	NetworkID string `json:"network_id"`
+
+	// NetworkName is the name of the network.
+	NetworkName string `json:"network_name"`
+
+	// ProjectID is the ID of the Identity project that owns the network.
+	ProjectID string `json:"project_id"`
+
+	// TenantID is the ID of the Identity project that owns the network.
+	TenantID string `json:"tenant_id"`
+
+	// SubnetIPAvailabilities contains the IP usage of each subnet of the
+	// network.
+	SubnetIPAvailabilities []SubnetIPAvailability `json:"subnet_ip_availability"`
+
+	// TotalIPs is the total number of IP addresses in the network.
+	TotalIPs *big.Int `json:"-"`
+
+	// UsedIPs is the number of allocated IP addresses in the network.
+	UsedIPs *big.Int `json:"-"`
+}
+
+// FreeIPs returns the number of IP addresses which are still available in
+// the network.
+func (r NetworkIPAvailability) FreeIPs() *big.Int {
+	return freeIPs(r.TotalIPs, r.UsedIPs)
+}
+
+func (r *NetworkIPAvailability) UnmarshalJSON(b []byte) error {
+	type tmp NetworkIPAvailability
+	var s struct {
+		tmp
+		TotalIPs json.Number `json:"total_ips"`
+		UsedIPs  json.Number `json:"used_ips"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = NetworkIPAvailability(s.tmp)
+
+	r.TotalIPs, err = parseCounter(s.TotalIPs)
+	if err != nil {
+		return err
+	}
+	r.UsedIPs, err = parseCounter(s.UsedIPs)
+	return err
+}
+
+// SubnetIPAvailability represents the IP usage of a single subnet.
+type SubnetIPAvailability struct {
+	// SubnetID is the ID of the subnet.
+	SubnetID string `json:"subnet_id"`
+
+	// SubnetName is the name of the subnet.
+	SubnetName string `json:"subnet_name"`
+
+	// CIDR is the CIDR of the subnet.
+	CIDR string `json:"cidr"`
+
+	// IPVersion is the IP version of the subnet.
+	IPVersion int `json:"ip_version"`
+
+	// TotalIPs is the total number of IP addresses in the subnet.
+	TotalIPs *big.Int `json:"-"`
+
+	// UsedIPs is the number of allocated IP addresses in the subnet.
+	UsedIPs *big.Int `json:"-"`
+}
+
+// FreeIPs returns the number of IP addresses which are still available in
+// the subnet.
+func (r SubnetIPAvailability) FreeIPs() *big.Int {
+	return freeIPs(r.TotalIPs, r.UsedIPs)
+}
+
+func (r *SubnetIPAvailability) UnmarshalJSON(b []byte) error {
+	type tmp SubnetIPAvailability
+	var s struct {
+		tmp
+		TotalIPs json.Number `json:"total_ips"`
+		UsedIPs  json.Number `json:"used_ips"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = SubnetIPAvailability(s.tmp)
+
+	r.TotalIPs, err = parseCounter(s.TotalIPs)
+	if err != nil {
+		return err
+	}
+	r.UsedIPs, err = parseCounter(s.UsedIPs)
+	return err
+}
+
+// parseCounter converts a JSON number, which may be in exponent notation,
+// into a big.Int. A missing value is returned as zero.
+func parseCounter(n json.Number) (*big.Int, error) {
+	if n == "" {
+		return new(big.Int), nil
+	}
+	if i, ok := new(big.Int).SetString(n.String(), 10); ok {
+		return i, nil
+	}
+	f, _, err := big.ParseFloat(n.String(), 10, 128, big.ToNearestEven)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IP counter %q: %s", n, err)
+	}
+	i, _ := f.Int(nil)
+	return i, nil
+}
+
+func freeIPs(total, used *big.Int) *big.Int {
+	free := new(big.Int)
+	if total == nil {
+		return free
+	}
+	if used == nil {
+		return free.Set(total)
+	}
+	free.Sub(total, used)
+	if free.Sign() < 0 {
+		free.SetInt64(0)
+	}
+	return free
+}
+
+// NetworkIPAvailabilityPage stores a single page of NetworkIPAvailabilities
+// from the List call.
+type NetworkIPAvailabilityPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines whether or not a NetworkIPAvailabilityPage is empty.
+func (r NetworkIPAvailabilityPage) IsEmpty() (bool, error) {
+	networkIPAvailabilities, err := ExtractNetworkIPAvailabilities(r)
+	return len(networkIPAvailabilities) == 0, err
+}
+
+// ExtractNetworkIPAvailabilities interprets the results of a single page from
+// a List() API call, producing a slice of NetworkIPAvailability structures.
+func ExtractNetworkIPAvailabilities(r pagination.Page) ([]NetworkIPAvailability, error) {
+	var s struct {
+		NetworkIPAvailabilities []NetworkIPAvailability `json:"network_ip_availabilities"`
+	}
+	err := (r.(NetworkIPAvailabilityPage)).ExtractInto(&s)
+	return s.NetworkIPAvailabilities, err
+}
@@ -0,0 +1,99 @@
+package testing
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/networkipavailabilities"
+	"github.com/chjlangzi/gophercloud/pagination"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network-ip-availabilities", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"ip_version": "4"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, NetworkIPAvailabilityListResult)
+	})
+
+	count := 0
+
+	err := networkipavailabilities.List(fake.ServiceClient(), networkipavailabilities.ListOpts{IPVersion: 4}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := networkipavailabilities.ExtractNetworkIPAvailabilities(page)
+		if err != nil {
+			t.Errorf("Failed to extract network IP availabilities: %v", err)
+			return false, err
+		}
+
+		th.AssertEquals(t, 2, len(actual))
+
+		private := actual[0]
+		th.AssertEquals(t, "private", private.NetworkName)
+		th.AssertEquals(t, "4", private.UsedIPs.String())
+		th.AssertEquals(t, 2, len(private.SubnetIPAvailabilities))
+
+		// IPv6 counters exceed the range of int64.
+		ipv6 := private.SubnetIPAvailabilities[0]
+		th.AssertEquals(t, 6, ipv6.IPVersion)
+		th.AssertEquals(t, 1, ipv6.TotalIPs.Cmp(new(big.Int).SetUint64(1<<63)))
+		th.AssertEquals(t, "2", ipv6.UsedIPs.String())
+
+		ipv4 := private.SubnetIPAvailabilities[1]
+		th.AssertEquals(t, "521f47e7-c4fb-452c-b71a-851da38cc571", ipv4.SubnetID)
+		th.AssertEquals(t, "10.0.0.0/26", ipv4.CIDR)
+		th.AssertEquals(t, "61", ipv4.TotalIPs.String())
+		th.AssertEquals(t, "59", ipv4.FreeIPs().String())
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/network-ip-availabilities/cf11ab78-2302-49fa-870f-851a08c7afb8", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, NetworkIPAvailabilityGetResult)
+	})
+
+	s, err := networkipavailabilities.Get(fake.ServiceClient(), "cf11ab78-2302-49fa-870f-851a08c7afb8").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "cf11ab78-2302-49fa-870f-851a08c7afb8", s.NetworkID)
+	th.AssertEquals(t, "public", s.NetworkName)
+	th.AssertEquals(t, "424e7cf0243c468ca61732ba45973b3e", s.ProjectID)
+	th.AssertEquals(t, "253", s.TotalIPs.String())
+	th.AssertEquals(t, "3", s.UsedIPs.String())
+	th.AssertEquals(t, "250", s.FreeIPs().String())
+
+	th.AssertEquals(t, 1, len(s.SubnetIPAvailabilities))
+	subnet := s.SubnetIPAvailabilities[0]
+	th.AssertEquals(t, "4afe6e5f-9649-40db-b18f-64c7ead942bd", subnet.SubnetID)
+	th.AssertEquals(t, "public-subnet", subnet.SubnetName)
+	th.AssertEquals(t, "203.0.113.0/24", subnet.CIDR)
+	th.AssertEquals(t, 4, subnet.IPVersion)
+	th.AssertEquals(t, "250", subnet.FreeIPs().String())
+}
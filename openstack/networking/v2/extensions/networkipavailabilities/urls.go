@@ -0,0 +1,21 @@
+package networkipavailabilities
+
+import "github.com/chjlangzi/gophercloud"
+
+const resourcePath = "network-ip-availabilities"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, networkID string) string {
+	return c.ServiceURL(resourcePath, networkID)
+}
+
+func listURL(c *gophercloud.ServiceClient) string {
+	return rootURL(c)
+}
+
+func getURL(c *gophercloud.ServiceClient, networkID string) string {
+	return resourceURL(c, networkID)
+}
@@ -69,5 +69,43 @@ Example to Create a Provider Network
 	if err != nil {
 		panic(err)
 	}
+
+Example to List Networks by Provider Attributes
+
+	listOpts := provider.ListOptsExt{
+		ListOptsBuilder: networks.ListOpts{},
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+	}
+
+	allPages, err := networks.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update the Segments of a Multi-Provider Network
+
+	segments := []provider.Segment{
+		provider.Segment{
+			NetworkType:     "vlan",
+			PhysicalNetwork: "physnet1",
+			SegmentationID:  100,
+		},
+		provider.Segment{
+			NetworkType:     "vlan",
+			PhysicalNetwork: "physnet2",
+			SegmentationID:  200,
+		},
+	}
+
+	updateOpts := provider.UpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{},
+		Segments:          &segments,
+	}
+
+	network, err := networks.Update(networkClient, networkID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
 */
 package provider
@@ -1,9 +1,43 @@
 package provider
 
 import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/internal"
 	"github.com/chjlangzi/gophercloud/openstack/networking/v2/networks"
 )
 
+// ListOptsExt adds provider filters to the base networks.ListOpts.
+type ListOptsExt struct {
+	networks.ListOptsBuilder
+
+	// NetworkType filters the networks by their provider:network_type.
+	NetworkType string `q:"provider:network_type"`
+
+	// PhysicalNetwork filters the networks by their
+	// provider:physical_network.
+	PhysicalNetwork string `q:"provider:physical_network"`
+
+	// SegmentationID filters the networks by their
+	// provider:segmentation_id.
+	SegmentationID int `q:"provider:segmentation_id"`
+}
+
+// ToNetworkListQuery adds the provider filters to the base network list
+// query.
+func (opts ListOptsExt) ToNetworkListQuery() (string, error) {
+	base, err := opts.ListOptsBuilder.ToNetworkListQuery()
+	if err != nil {
+		return "", err
+	}
+
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return internal.MergeQuery(base, q.String()), nil
+}
+
 // CreateOptsExt adds a Segments option to the base Network CreateOpts.
 type CreateOptsExt struct {
 	networks.CreateOptsBuilder
@@ -26,3 +60,27 @@ func (opts CreateOptsExt) ToNetworkCreateMap() (map[string]interface{}, error) {
 
 	return base, nil
 }
+
+// UpdateOptsExt adds a Segments option to the base Network UpdateOpts. Setting
+// Segments replaces all the segments of a multi-provider network.
+type UpdateOptsExt struct {
+	networks.UpdateOptsBuilder
+	Segments *[]Segment `json:"segments,omitempty"`
+}
+
+// ToNetworkUpdateMap adds segments to the base network update options.
+func (opts UpdateOptsExt) ToNetworkUpdateMap() (map[string]interface{}, error) {
+	base, err := opts.UpdateOptsBuilder.ToNetworkUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Segments == nil {
+		return base, nil
+	}
+
+	providerMap := base["network"].(map[string]interface{})
+	providerMap["segments"] = opts.Segments
+
+	return base, nil
+}
@@ -217,3 +217,114 @@ func TestUpdate(t *testing.T) {
 	th.AssertEquals(t, "local", s.NetworkType)
 	th.AssertEquals(t, "1234567890", s.SegmentationID)
 }
+
+func TestListWithProviderFilters(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name":                      "private",
+			"provider:network_type":     "vlan",
+			"provider:physical_network": "physnet1",
+			"provider:segmentation_id":  "100",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, nettest.ListResponse)
+	})
+
+	listOpts := provider.ListOptsExt{
+		ListOptsBuilder: networks.ListOpts{Name: "private"},
+		NetworkType:     "vlan",
+		PhysicalNetwork: "physnet1",
+		SegmentationID:  100,
+	}
+
+	allPages, err := networks.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	_, err = networks.ExtractNetworks(allPages)
+	th.AssertNoErr(t, err)
+}
+
+func TestUpdateWithMultipleProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, `
+{
+	"network": {
+			"name": "new_network_name",
+			"segments": [
+				{
+					"provider:segmentation_id": 100,
+					"provider:physical_network": "physnet1",
+					"provider:network_type": "vlan"
+				},
+				{
+					"provider:segmentation_id": 200,
+					"provider:physical_network": "physnet2",
+					"provider:network_type": "vlan"
+				}
+			]
+	}
+}
+		`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `
+{
+	"network": {
+		"id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+		"status": "ACTIVE",
+		"name": "new_network_name",
+		"admin_state_up": true,
+		"segments": [
+			{
+				"provider:segmentation_id": 100,
+				"provider:physical_network": "physnet1",
+				"provider:network_type": "vlan"
+			},
+			{
+				"provider:segmentation_id": 200,
+				"provider:physical_network": "physnet2",
+				"provider:network_type": "vlan"
+			}
+		]
+	}
+}
+	`)
+	})
+
+	segments := []provider.Segment{
+		provider.Segment{NetworkType: "vlan", PhysicalNetwork: "physnet1", SegmentationID: 100},
+		provider.Segment{NetworkType: "vlan", PhysicalNetwork: "physnet2", SegmentationID: 200},
+	}
+
+	updateOpts := provider.UpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{Name: "new_network_name"},
+		Segments:          &segments,
+	}
+
+	var s struct {
+		networks.Network
+		provider.NetworkProviderExt
+	}
+
+	err := networks.Update(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", updateOpts).ExtractInto(&s)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "new_network_name", s.Name)
+	th.AssertDeepEquals(t, segments, s.Segments)
+}
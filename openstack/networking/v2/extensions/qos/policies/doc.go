@@ -0,0 +1,125 @@
+/*
+Package policies provides information and interaction with the QoS policy
+extension for the OpenStack Networking service. It also provides extensions
+to the ports and networks packages to attach a QoS policy.
+
+Example to List QoS policies
+
+	shared := true
+	listOpts := policies.ListOpts{
+		Name:   "shared-policy",
+		Shared: &shared,
+	}
+
+	allPages, err := policies.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allPolicies, err := policies.ExtractPolicies(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, policy := range allPolicies {
+		fmt.Printf("%+v\n", policy)
+	}
+
+Example to Get a QoS policy
+
+	policyID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	policy, err := policies.Get(networkClient, policyID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a QoS policy
+
+	createOpts := policies.CreateOpts{
+		Name:      "shared-default-policy",
+		Shared:    true,
+		IsDefault: true,
+	}
+
+	policy, err := policies.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a QoS policy
+
+	shared := false
+	description := ""
+	updateOpts := policies.UpdateOpts{
+		Name:        "new-name",
+		Shared:      &shared,
+		Description: &description,
+	}
+
+	policyID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	policy, err := policies.Update(networkClient, policyID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a QoS policy
+
+	policyID := "30a57f4a-336b-4382-8275-d708babd2241"
+
+	err := policies.Delete(networkClient, policyID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Port with a QoS policy
+
+	var portWithQoS struct {
+		ports.Port
+		policies.QoSPolicyExt
+	}
+
+	portCreateOpts := ports.CreateOpts{
+		Name:      "port_1",
+		NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+	}
+
+	createOpts := policies.PortCreateOptsExt{
+		CreateOptsBuilder: portCreateOpts,
+		QoSPolicyID:       "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+	}
+
+	err = ports.Create(networkClient, createOpts).ExtractInto(&portWithQoS)
+	if err != nil {
+		panic(err)
+	}
+
+Example to remove a QoS policy from a Network
+
+	policyID := ""
+	updateOpts := policies.NetworkUpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{},
+		QoSPolicyID:       &policyID,
+	}
+
+	networkID := "7e8e6a5c-8ae0-4b34-b4ab-f0ee5c9a0b60"
+
+	err = networks.Update(networkClient, networkID, updateOpts).Err
+	if err != nil {
+		panic(err)
+	}
+
+Example to List Ports using a QoS policy
+
+	listOpts := policies.PortListOptsExt{
+		ListOptsBuilder: ports.ListOpts{},
+		QoSPolicyID:     "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+	}
+
+	allPages, err := ports.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+*/
+package policies
@@ -0,0 +1,2 @@
+// qos policies unit tests
+package testing
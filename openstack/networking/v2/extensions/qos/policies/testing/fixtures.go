@@ -0,0 +1,330 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/qos/policies"
+)
+
+// CreatePortRequest with QoS policy ID.
+const CreatePortRequest = `
+{
+    "port": {
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08"
+    }
+}
+`
+
+// CreatePortResponse with QoS policy ID.
+const CreatePortResponse = `
+{
+    "port": {
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08",
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d"
+    }
+}
+`
+
+// UpdatePortWithPolicyRequest with QoS policy ID.
+const UpdatePortWithPolicyRequest = `
+{
+    "port": {
+        "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08"
+    }
+}
+`
+
+// UpdatePortWithPolicyResponse with QoS policy ID.
+const UpdatePortWithPolicyResponse = `
+{
+    "port": {
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08",
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d"
+    }
+}
+`
+
+// UpdatePortWithoutPolicyRequest without QoS policy ID.
+const UpdatePortWithoutPolicyRequest = `
+{
+    "port": {
+        "qos_policy_id": null
+    }
+}
+`
+
+// UpdatePortWithoutPolicyResponse without QoS policy ID.
+const UpdatePortWithoutPolicyResponse = `
+{
+    "port": {
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "qos_policy_id": "",
+        "id": "65c0ee9f-d634-4522-8954-51021b570b0d"
+    }
+}
+`
+
+// ListPortsResponse lists a port with a QoS policy ID.
+const ListPortsResponse = `
+{
+    "ports": [
+        {
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08",
+            "id": "65c0ee9f-d634-4522-8954-51021b570b0d"
+        }
+    ]
+}
+`
+
+// CreateNetworkRequest with QoS policy ID.
+const CreateNetworkRequest = `
+{
+    "network": {
+        "name": "private",
+        "admin_state_up": true,
+        "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08"
+    }
+}
+`
+
+// CreateNetworkResponse with QoS policy ID.
+const CreateNetworkResponse = `
+{
+    "network": {
+        "name": "private",
+        "admin_state_up": true,
+        "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08",
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+    }
+}
+`
+
+// UpdateNetworkWithPolicyRequest with QoS policy ID.
+const UpdateNetworkWithPolicyRequest = `
+{
+    "network": {
+        "name": "updated",
+        "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08"
+    }
+}
+`
+
+// UpdateNetworkWithPolicyResponse with QoS policy ID.
+const UpdateNetworkWithPolicyResponse = `
+{
+    "network": {
+        "name": "updated",
+        "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08",
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+    }
+}
+`
+
+// UpdateNetworkWithoutPolicyRequest without QoS policy ID.
+const UpdateNetworkWithoutPolicyRequest = `
+{
+    "network": {
+        "qos_policy_id": null
+    }
+}
+`
+
+// UpdateNetworkWithoutPolicyResponse without QoS policy ID.
+const UpdateNetworkWithoutPolicyResponse = `
+{
+    "network": {
+        "name": "private",
+        "qos_policy_id": "",
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+    }
+}
+`
+
+// ListNetworksResponse lists a network with a QoS policy ID.
+const ListNetworksResponse = `
+{
+    "networks": [
+        {
+            "name": "private",
+            "qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08",
+            "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c"
+        }
+    ]
+}
+`
+
+// ListPoliciesResponse represents a raw policies list response.
+const ListPoliciesResponse = `
+{
+    "policies": [
+        {
+            "name": "bw-limiter",
+            "tags": [],
+            "rules": [
+                {
+                    "max_kbps": 3000,
+                    "direction": "egress",
+                    "qos_policy_id": "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+                    "type": "bandwidth_limit",
+                    "id": "30a57f4a-336b-4382-8275-d708babd2241",
+                    "max_burst_kbps": 300
+                }
+            ],
+            "tenant_id": "a77cbe0998374aed9a6798ad6c61677e",
+            "created_at": "2019-05-19T11:17:50Z",
+            "updated_at": "2019-05-19T11:17:57Z",
+            "is_default": false,
+            "revision_number": 1,
+            "shared": false,
+            "project_id": "a77cbe0998374aed9a6798ad6c61677e",
+            "id": "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+            "description": ""
+        },
+        {
+            "name": "no-rules",
+            "tags": ["limit"],
+            "rules": [],
+            "tenant_id": "a77cbe0998374aed9a6798ad6c61677e",
+            "created_at": "2019-06-01T10:38:58Z",
+            "updated_at": "2019-06-01T10:38:58Z",
+            "is_default": false,
+            "revision_number": 0,
+            "shared": false,
+            "project_id": "a77cbe0998374aed9a6798ad6c61677e",
+            "id": "099c2fe5-d237-4bac-9e9a-c6fe0e2a1aab",
+            "description": ""
+        }
+    ]
+}
+`
+
+// Policy1 is an expected representation of a first policy from the ListPoliciesResponse.
+var Policy1 = policies.Policy{
+	Name: "bw-limiter",
+	Rules: []map[string]interface{}{
+		{
+			"type":           "bandwidth_limit",
+			"max_kbps":       float64(3000),
+			"direction":      "egress",
+			"qos_policy_id":  "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+			"max_burst_kbps": float64(300),
+			"id":             "30a57f4a-336b-4382-8275-d708babd2241",
+		},
+	},
+	Tags:           []string{},
+	TenantID:       "a77cbe0998374aed9a6798ad6c61677e",
+	CreatedAt:      time.Date(2019, 5, 19, 11, 17, 50, 0, time.UTC),
+	UpdatedAt:      time.Date(2019, 5, 19, 11, 17, 57, 0, time.UTC),
+	RevisionNumber: 1,
+	ProjectID:      "a77cbe0998374aed9a6798ad6c61677e",
+	ID:             "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+}
+
+// Policy2 is an expected representation of a second policy from the ListPoliciesResponse.
+var Policy2 = policies.Policy{
+	Name:           "no-rules",
+	Tags:           []string{"limit"},
+	Rules:          []map[string]interface{}{},
+	TenantID:       "a77cbe0998374aed9a6798ad6c61677e",
+	CreatedAt:      time.Date(2019, 6, 1, 10, 38, 58, 0, time.UTC),
+	UpdatedAt:      time.Date(2019, 6, 1, 10, 38, 58, 0, time.UTC),
+	RevisionNumber: 0,
+	ProjectID:      "a77cbe0998374aed9a6798ad6c61677e",
+	ID:             "099c2fe5-d237-4bac-9e9a-c6fe0e2a1aab",
+}
+
+// GetPolicyResponse represents a raw policy response.
+const GetPolicyResponse = `
+{
+    "policy": {
+        "name": "bw-limiter",
+        "tags": [],
+        "rules": [
+            {
+                "max_kbps": 3000,
+                "direction": "egress",
+                "qos_policy_id": "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+                "type": "bandwidth_limit",
+                "id": "30a57f4a-336b-4382-8275-d708babd2241",
+                "max_burst_kbps": 300
+            }
+        ],
+        "tenant_id": "a77cbe0998374aed9a6798ad6c61677e",
+        "created_at": "2019-05-19T11:17:50Z",
+        "updated_at": "2019-05-19T11:17:57Z",
+        "is_default": false,
+        "revision_number": 1,
+        "shared": false,
+        "project_id": "a77cbe0998374aed9a6798ad6c61677e",
+        "id": "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+        "description": ""
+    }
+}
+`
+
+// CreatePolicyRequest represents a raw policy create request.
+const CreatePolicyRequest = `
+{
+    "policy": {
+        "name": "shared-default-policy",
+        "is_default": true,
+        "shared": true,
+        "description": "use-me"
+    }
+}
+`
+
+// CreatePolicyResponse represents a raw policy create response.
+const CreatePolicyResponse = `
+{
+    "policy": {
+        "name": "shared-default-policy",
+        "tags": [],
+        "rules": [],
+        "tenant_id": "a77cbe0998374aed9a6798ad6c61677e",
+        "created_at": "2019-05-19T11:17:50Z",
+        "updated_at": "2019-05-19T11:17:50Z",
+        "is_default": true,
+        "revision_number": 0,
+        "shared": true,
+        "project_id": "a77cbe0998374aed9a6798ad6c61677e",
+        "id": "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+        "description": "use-me"
+    }
+}
+`
+
+// UpdatePolicyRequest represents a raw policy update request.
+const UpdatePolicyRequest = `
+{
+    "policy": {
+        "name": "new-name",
+        "shared": true,
+        "description": ""
+    }
+}
+`
+
+// UpdatePolicyResponse represents a raw policy update response.
+const UpdatePolicyResponse = `
+{
+    "policy": {
+        "name": "new-name",
+        "tags": [],
+        "rules": [],
+        "tenant_id": "a77cbe0998374aed9a6798ad6c61677e",
+        "created_at": "2019-05-19T11:17:50Z",
+        "updated_at": "2019-06-01T13:17:57Z",
+        "is_default": false,
+        "revision_number": 1,
+        "shared": true,
+        "project_id": "a77cbe0998374aed9a6798ad6c61677e",
+        "id": "d6ae28ce-fcb5-4180-aa62-d260a27e09ae",
+        "description": ""
+    }
+}
+`
@@ -0,0 +1,444 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/qos/policies"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/networks"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/ports"
+	"github.com/chjlangzi/gophercloud/pagination"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestCreatePort(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreatePortRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreatePortResponse)
+	})
+
+	var p struct {
+		ports.Port
+		policies.QoSPolicyExt
+	}
+	portCreateOpts := ports.CreateOpts{
+		NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+	}
+	createOpts := policies.PortCreateOptsExt{
+		CreateOptsBuilder: portCreateOpts,
+		QoSPolicyID:       "591e0597-39a6-4665-8149-2111d8de9a08",
+	}
+	err := ports.Create(fake.ServiceClient(), createOpts).ExtractInto(&p)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, p.NetworkID, "a87cc70a-3e15-4acf-8205-9b711a3531b7")
+	th.AssertEquals(t, p.ID, "65c0ee9f-d634-4522-8954-51021b570b0d")
+	th.AssertEquals(t, p.QoSPolicyID, "591e0597-39a6-4665-8149-2111d8de9a08")
+}
+
+func TestUpdatePortWithPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/65c0ee9f-d634-4522-8954-51021b570b0d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdatePortWithPolicyRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdatePortWithPolicyResponse)
+	})
+
+	policyID := "591e0597-39a6-4665-8149-2111d8de9a08"
+
+	var p struct {
+		ports.Port
+		policies.QoSPolicyExt
+	}
+	updateOpts := policies.PortUpdateOptsExt{
+		UpdateOptsBuilder: ports.UpdateOpts{},
+		QoSPolicyID:       &policyID,
+	}
+	err := ports.Update(fake.ServiceClient(), "65c0ee9f-d634-4522-8954-51021b570b0d", updateOpts).ExtractInto(&p)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, p.ID, "65c0ee9f-d634-4522-8954-51021b570b0d")
+	th.AssertEquals(t, p.QoSPolicyID, "591e0597-39a6-4665-8149-2111d8de9a08")
+}
+
+func TestUpdatePortWithoutPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/65c0ee9f-d634-4522-8954-51021b570b0d", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdatePortWithoutPolicyRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdatePortWithoutPolicyResponse)
+	})
+
+	policyID := ""
+
+	var p struct {
+		ports.Port
+		policies.QoSPolicyExt
+	}
+	updateOpts := policies.PortUpdateOptsExt{
+		UpdateOptsBuilder: ports.UpdateOpts{},
+		QoSPolicyID:       &policyID,
+	}
+	err := ports.Update(fake.ServiceClient(), "65c0ee9f-d634-4522-8954-51021b570b0d", updateOpts).ExtractInto(&p)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, p.ID, "65c0ee9f-d634-4522-8954-51021b570b0d")
+	th.AssertEquals(t, p.QoSPolicyID, "")
+}
+
+func TestListPortsWithPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"network_id":    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+			"qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08",
+		})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListPortsResponse)
+	})
+
+	var allPorts []struct {
+		ports.Port
+		policies.QoSPolicyExt
+	}
+	listOpts := policies.PortListOptsExt{
+		ListOptsBuilder: ports.ListOpts{
+			NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+		QoSPolicyID: "591e0597-39a6-4665-8149-2111d8de9a08",
+	}
+	allPages, err := ports.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	err = ports.ExtractPortsInto(allPages, &allPorts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(allPorts))
+	th.AssertEquals(t, allPorts[0].QoSPolicyID, "591e0597-39a6-4665-8149-2111d8de9a08")
+}
+
+func TestCreateNetwork(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateNetworkRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreateNetworkResponse)
+	})
+
+	var n struct {
+		networks.Network
+		policies.QoSPolicyExt
+	}
+	iTrue := true
+	networkCreateOpts := networks.CreateOpts{
+		Name:         "private",
+		AdminStateUp: &iTrue,
+	}
+	createOpts := policies.NetworkCreateOptsExt{
+		CreateOptsBuilder: networkCreateOpts,
+		QoSPolicyID:       "591e0597-39a6-4665-8149-2111d8de9a08",
+	}
+	err := networks.Create(fake.ServiceClient(), createOpts).ExtractInto(&n)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, n.ID, "4e8e5957-649f-477b-9e5b-f1f75b21c03c")
+	th.AssertEquals(t, n.QoSPolicyID, "591e0597-39a6-4665-8149-2111d8de9a08")
+}
+
+func TestUpdateNetworkWithPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateNetworkWithPolicyRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateNetworkWithPolicyResponse)
+	})
+
+	policyID := "591e0597-39a6-4665-8149-2111d8de9a08"
+
+	var n struct {
+		networks.Network
+		policies.QoSPolicyExt
+	}
+	updateOpts := policies.NetworkUpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{
+			Name: "updated",
+		},
+		QoSPolicyID: &policyID,
+	}
+	err := networks.Update(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", updateOpts).ExtractInto(&n)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, n.Name, "updated")
+	th.AssertEquals(t, n.QoSPolicyID, "591e0597-39a6-4665-8149-2111d8de9a08")
+}
+
+func TestUpdateNetworkWithoutPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateNetworkWithoutPolicyRequest)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdateNetworkWithoutPolicyResponse)
+	})
+
+	policyID := ""
+
+	var n struct {
+		networks.Network
+		policies.QoSPolicyExt
+	}
+	updateOpts := policies.NetworkUpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{},
+		QoSPolicyID:       &policyID,
+	}
+	err := networks.Update(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", updateOpts).ExtractInto(&n)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, n.QoSPolicyID, "")
+}
+
+func TestListNetworksWithPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"qos_policy_id": "591e0597-39a6-4665-8149-2111d8de9a08",
+		})
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListNetworksResponse)
+	})
+
+	var allNetworks []struct {
+		networks.Network
+		policies.QoSPolicyExt
+	}
+	listOpts := policies.NetworkListOptsExt{
+		ListOptsBuilder: networks.ListOpts{},
+		QoSPolicyID:     "591e0597-39a6-4665-8149-2111d8de9a08",
+	}
+	allPages, err := networks.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	err = networks.ExtractNetworksInto(allPages, &allNetworks)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(allNetworks))
+	th.AssertEquals(t, allNetworks[0].QoSPolicyID, "591e0597-39a6-4665-8149-2111d8de9a08")
+}
+
+func TestListPolicies(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"shared":   "false",
+			"tags-any": "limit,other",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, ListPoliciesResponse)
+	})
+
+	shared := false
+	count := 0
+
+	err := policies.List(fake.ServiceClient(), policies.ListOpts{
+		Shared:  &shared,
+		TagsAny: "limit,other",
+	}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := policies.ExtractPolicies(page)
+		if err != nil {
+			t.Errorf("Failed to extract policies: %v", err)
+			return false, nil
+		}
+
+		expected := []policies.Policy{
+			Policy1,
+			Policy2,
+		}
+
+		th.CheckDeepEquals(t, expected, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetPolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/d6ae28ce-fcb5-4180-aa62-d260a27e09ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, GetPolicyResponse)
+	})
+
+	p, err := policies.Get(fake.ServiceClient(), "d6ae28ce-fcb5-4180-aa62-d260a27e09ae").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Policy1, p)
+}
+
+func TestCreatePolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreatePolicyRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, CreatePolicyResponse)
+	})
+
+	opts := policies.CreateOpts{
+		Name:        "shared-default-policy",
+		Shared:      true,
+		IsDefault:   true,
+		Description: "use-me",
+	}
+	p, err := policies.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, p.Name, "shared-default-policy")
+	th.AssertEquals(t, p.Shared, true)
+	th.AssertEquals(t, p.IsDefault, true)
+	th.AssertEquals(t, p.Description, "use-me")
+	th.AssertEquals(t, p.TenantID, "a77cbe0998374aed9a6798ad6c61677e")
+	th.AssertEquals(t, p.ProjectID, "a77cbe0998374aed9a6798ad6c61677e")
+	th.AssertEquals(t, p.CreatedAt, time.Date(2019, 5, 19, 11, 17, 50, 0, time.UTC))
+	th.AssertEquals(t, p.RevisionNumber, 0)
+	th.AssertEquals(t, p.ID, "d6ae28ce-fcb5-4180-aa62-d260a27e09ae")
+}
+
+func TestUpdatePolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/d6ae28ce-fcb5-4180-aa62-d260a27e09ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdatePolicyRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, UpdatePolicyResponse)
+	})
+
+	shared := true
+	description := ""
+	opts := policies.UpdateOpts{
+		Name:        "new-name",
+		Shared:      &shared,
+		Description: &description,
+	}
+	p, err := policies.Update(fake.ServiceClient(), "d6ae28ce-fcb5-4180-aa62-d260a27e09ae", opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, p.Name, "new-name")
+	th.AssertEquals(t, p.Shared, true)
+	th.AssertEquals(t, p.IsDefault, false)
+	th.AssertEquals(t, p.Description, "")
+	th.AssertEquals(t, p.UpdatedAt, time.Date(2019, 6, 1, 13, 17, 57, 0, time.UTC))
+	th.AssertEquals(t, p.RevisionNumber, 1)
+}
+
+func TestDeletePolicy(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/d6ae28ce-fcb5-4180-aa62-d260a27e09ae", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := policies.Delete(fake.ServiceClient(), "d6ae28ce-fcb5-4180-aa62-d260a27e09ae")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,2 @@
+// qos rules unit tests
+package testing
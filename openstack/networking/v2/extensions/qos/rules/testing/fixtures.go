@@ -0,0 +1,238 @@
+package testing
+
+import (
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/qos/rules"
+)
+
+// BandwidthLimitRulesListResult represents a raw result of a ListBandwidthLimitRules call.
+const BandwidthLimitRulesListResult = `
+{
+    "bandwidth_limit_rules": [
+        {
+            "max_kbps": 3000,
+            "max_burst_kbps": 300,
+            "direction": "egress",
+            "id": "30a57f4a-336b-4382-8275-d708babd2241",
+            "tenant_id": "a77cbe0998374aed9a6798ad6c61677e"
+        }
+    ]
+}
+`
+
+// BandwidthLimitRuleGetResult represents a raw result of a GetBandwidthLimitRule call.
+const BandwidthLimitRuleGetResult = `
+{
+    "bandwidth_limit_rule": {
+        "max_kbps": 3000,
+        "max_burst_kbps": 300,
+        "direction": "egress",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "tenant_id": "a77cbe0998374aed9a6798ad6c61677e"
+    }
+}
+`
+
+// BandwidthLimitRuleCreateRequest represents a raw body of a CreateBandwidthLimitRule call.
+const BandwidthLimitRuleCreateRequest = `
+{
+    "bandwidth_limit_rule": {
+        "max_kbps": 3000,
+        "max_burst_kbps": 300,
+        "direction": "egress"
+    }
+}
+`
+
+// BandwidthLimitRuleUpdateRequest represents a raw body of an UpdateBandwidthLimitRule call.
+const BandwidthLimitRuleUpdateRequest = `
+{
+    "bandwidth_limit_rule": {
+        "max_kbps": 500,
+        "max_burst_kbps": 0
+    }
+}
+`
+
+// BandwidthLimitRule1 is the expected representation of BandwidthLimitRuleListResult and
+// BandwidthLimitRuleGetResult.
+var BandwidthLimitRule1 = rules.BandwidthLimitRule{
+	ID:           "30a57f4a-336b-4382-8275-d708babd2241",
+	TenantID:     "a77cbe0998374aed9a6798ad6c61677e",
+	MaxKBps:      3000,
+	MaxBurstKBps: 300,
+	Direction:    "egress",
+}
+
+// DSCPMarkingRulesListResult represents a raw result of a ListDSCPMarkingRules call.
+const DSCPMarkingRulesListResult = `
+{
+    "dscp_marking_rules": [
+        {
+            "dscp_mark": 26,
+            "id": "30a57f4a-336b-4382-8275-d708babd2241",
+            "tenant_id": "a77cbe0998374aed9a6798ad6c61677e"
+        }
+    ]
+}
+`
+
+// DSCPMarkingRuleGetResult represents a raw result of a GetDSCPMarkingRule call.
+const DSCPMarkingRuleGetResult = `
+{
+    "dscp_marking_rule": {
+        "dscp_mark": 26,
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "tenant_id": "a77cbe0998374aed9a6798ad6c61677e"
+    }
+}
+`
+
+// DSCPMarkingRuleCreateRequest represents a raw body of a CreateDSCPMarkingRule call.
+const DSCPMarkingRuleCreateRequest = `
+{
+    "dscp_marking_rule": {
+        "dscp_mark": 26
+    }
+}
+`
+
+// DSCPMarkingRuleUpdateRequest represents a raw body of an UpdateDSCPMarkingRule call.
+const DSCPMarkingRuleUpdateRequest = `
+{
+    "dscp_marking_rule": {
+        "dscp_mark": 20
+    }
+}
+`
+
+// DSCPMarkingRule1 is the expected representation of DSCPMarkingRuleListResult and
+// DSCPMarkingRuleGetResult.
+var DSCPMarkingRule1 = rules.DSCPMarkingRule{
+	ID:       "30a57f4a-336b-4382-8275-d708babd2241",
+	TenantID: "a77cbe0998374aed9a6798ad6c61677e",
+	DSCPMark: 26,
+}
+
+// MinimumBandwidthRulesListResult represents a raw result of a ListMinimumBandwidthRules call.
+const MinimumBandwidthRulesListResult = `
+{
+    "minimum_bandwidth_rules": [
+        {
+            "min_kbps": 1000,
+            "direction": "egress",
+            "id": "30a57f4a-336b-4382-8275-d708babd2241",
+            "tenant_id": "a77cbe0998374aed9a6798ad6c61677e"
+        }
+    ]
+}
+`
+
+// MinimumBandwidthRuleGetResult represents a raw result of a GetMinimumBandwidthRule call.
+const MinimumBandwidthRuleGetResult = `
+{
+    "minimum_bandwidth_rule": {
+        "min_kbps": 1000,
+        "direction": "egress",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "tenant_id": "a77cbe0998374aed9a6798ad6c61677e"
+    }
+}
+`
+
+// MinimumBandwidthRuleCreateRequest represents a raw body of a CreateMinimumBandwidthRule call.
+const MinimumBandwidthRuleCreateRequest = `
+{
+    "minimum_bandwidth_rule": {
+        "min_kbps": 1000,
+        "direction": "egress"
+    }
+}
+`
+
+// MinimumBandwidthRuleUpdateRequest represents a raw body of an UpdateMinimumBandwidthRule call.
+const MinimumBandwidthRuleUpdateRequest = `
+{
+    "minimum_bandwidth_rule": {
+        "min_kbps": 500
+    }
+}
+`
+
+// MinimumBandwidthRule1 is the expected representation of MinimumBandwidthRuleListResult and
+// MinimumBandwidthRuleGetResult.
+var MinimumBandwidthRule1 = rules.MinimumBandwidthRule{
+	ID:        "30a57f4a-336b-4382-8275-d708babd2241",
+	TenantID:  "a77cbe0998374aed9a6798ad6c61677e",
+	MinKBps:   1000,
+	Direction: "egress",
+}
+
+// MinimumPacketRateRulesListResult represents a raw result of a ListMinimumPacketRateRules call.
+const MinimumPacketRateRulesListResult = `
+{
+    "minimum_packet_rate_rules": [
+        {
+            "min_kpps": 1000,
+            "direction": "any",
+            "id": "30a57f4a-336b-4382-8275-d708babd2241",
+            "tenant_id": "a77cbe0998374aed9a6798ad6c61677e"
+        }
+    ]
+}
+`
+
+// MinimumPacketRateRuleGetResult represents a raw result of a GetMinimumPacketRateRule call.
+const MinimumPacketRateRuleGetResult = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 1000,
+        "direction": "any",
+        "id": "30a57f4a-336b-4382-8275-d708babd2241",
+        "tenant_id": "a77cbe0998374aed9a6798ad6c61677e"
+    }
+}
+`
+
+// MinimumPacketRateRuleCreateRequest represents a raw body of a CreateMinimumPacketRateRule call.
+const MinimumPacketRateRuleCreateRequest = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 1000,
+        "direction": "any"
+    }
+}
+`
+
+// MinimumPacketRateRuleUpdateRequest represents a raw body of an UpdateMinimumPacketRateRule call.
+const MinimumPacketRateRuleUpdateRequest = `
+{
+    "minimum_packet_rate_rule": {
+        "min_kpps": 500
+    }
+}
+`
+
+// MinimumPacketRateRule1 is the expected representation of MinimumPacketRateRuleListResult and
+// MinimumPacketRateRuleGetResult.
+var MinimumPacketRateRule1 = rules.MinimumPacketRateRule{
+	ID:        "30a57f4a-336b-4382-8275-d708babd2241",
+	TenantID:  "a77cbe0998374aed9a6798ad6c61677e",
+	MinKpps:   1000,
+	Direction: "any",
+}
+
+// RulesPaginatedListResult is a format string for a raw result of a single
+// page of a rules list call. It takes the rule type (e.g.
+// "bandwidth_limit"), the ID of the only rule on the page and the JSON list of
+// links to other pages.
+const RulesPaginatedListResult = `
+{
+    "%[1]s_rules": [
+        {
+            "id": "%[2]s",
+            "tenant_id": "a77cbe0998374aed9a6798ad6c61677e"
+        }
+    ],
+    "%[1]s_rules_links": %[3]s
+}
+`
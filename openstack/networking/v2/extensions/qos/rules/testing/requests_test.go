@@ -0,0 +1,589 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/qos/rules"
+	"github.com/chjlangzi/gophercloud/pagination"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestListBandwidthLimitRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"max_kbps": "3000"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, BandwidthLimitRulesListResult)
+	})
+
+	count := 0
+
+	err := rules.ListBandwidthLimitRules(
+		fake.ServiceClient(),
+		"501005fa-3b56-4061-aaca-3f24995112e1",
+		rules.BandwidthLimitRulesListOpts{
+			MaxKBps: 3000,
+		},
+	).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := rules.ExtractBandwidthLimitRules(page)
+		if err != nil {
+			t.Errorf("Failed to extract bandwidth_limit_rules: %v", err)
+			return false, nil
+		}
+
+		th.CheckDeepEquals(t, []rules.BandwidthLimitRule{BandwidthLimitRule1}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetBandwidthLimitRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, BandwidthLimitRuleGetResult)
+	})
+
+	r, err := rules.GetBandwidthLimitRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &BandwidthLimitRule1, r)
+}
+
+func TestCreateBandwidthLimitRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, BandwidthLimitRuleCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, BandwidthLimitRuleGetResult)
+	})
+
+	opts := rules.CreateBandwidthLimitRuleOpts{
+		MaxKBps:      3000,
+		MaxBurstKBps: 300,
+		Direction:    rules.DirectionEgress,
+	}
+	r, err := rules.CreateBandwidthLimitRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &BandwidthLimitRule1, r)
+}
+
+func TestUpdateBandwidthLimitRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, BandwidthLimitRuleUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, BandwidthLimitRuleGetResult)
+	})
+
+	maxKBps := 500
+	maxBurstKBps := 0
+	opts := rules.UpdateBandwidthLimitRuleOpts{
+		MaxKBps:      &maxKBps,
+		MaxBurstKBps: &maxBurstKBps,
+	}
+	_, err := rules.UpdateBandwidthLimitRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241", opts).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteBandwidthLimitRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/bandwidth_limit_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := rules.DeleteBandwidthLimitRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListDSCPMarkingRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/dscp_marking_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"dscp_mark": "26"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, DSCPMarkingRulesListResult)
+	})
+
+	count := 0
+
+	err := rules.ListDSCPMarkingRules(
+		fake.ServiceClient(),
+		"501005fa-3b56-4061-aaca-3f24995112e1",
+		rules.DSCPMarkingRulesListOpts{
+			DSCPMark: 26,
+		},
+	).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := rules.ExtractDSCPMarkingRules(page)
+		if err != nil {
+			t.Errorf("Failed to extract dscp_marking_rules: %v", err)
+			return false, nil
+		}
+
+		th.CheckDeepEquals(t, []rules.DSCPMarkingRule{DSCPMarkingRule1}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetDSCPMarkingRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/dscp_marking_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, DSCPMarkingRuleGetResult)
+	})
+
+	r, err := rules.GetDSCPMarkingRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &DSCPMarkingRule1, r)
+}
+
+func TestCreateDSCPMarkingRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/dscp_marking_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, DSCPMarkingRuleCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, DSCPMarkingRuleGetResult)
+	})
+
+	opts := rules.CreateDSCPMarkingRuleOpts{
+		DSCPMark: 26,
+	}
+	r, err := rules.CreateDSCPMarkingRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &DSCPMarkingRule1, r)
+}
+
+func TestUpdateDSCPMarkingRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/dscp_marking_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, DSCPMarkingRuleUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, DSCPMarkingRuleGetResult)
+	})
+
+	dscpMark := 20
+	opts := rules.UpdateDSCPMarkingRuleOpts{
+		DSCPMark: &dscpMark,
+	}
+	_, err := rules.UpdateDSCPMarkingRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241", opts).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteDSCPMarkingRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/dscp_marking_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := rules.DeleteDSCPMarkingRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListMinimumBandwidthRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_bandwidth_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"min_kbps": "1000"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, MinimumBandwidthRulesListResult)
+	})
+
+	count := 0
+
+	err := rules.ListMinimumBandwidthRules(
+		fake.ServiceClient(),
+		"501005fa-3b56-4061-aaca-3f24995112e1",
+		rules.MinimumBandwidthRulesListOpts{
+			MinKBps: 1000,
+		},
+	).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := rules.ExtractMinimumBandwidthRules(page)
+		if err != nil {
+			t.Errorf("Failed to extract minimum_bandwidth_rules: %v", err)
+			return false, nil
+		}
+
+		th.CheckDeepEquals(t, []rules.MinimumBandwidthRule{MinimumBandwidthRule1}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetMinimumBandwidthRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_bandwidth_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, MinimumBandwidthRuleGetResult)
+	})
+
+	r, err := rules.GetMinimumBandwidthRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &MinimumBandwidthRule1, r)
+}
+
+func TestCreateMinimumBandwidthRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_bandwidth_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, MinimumBandwidthRuleCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, MinimumBandwidthRuleGetResult)
+	})
+
+	opts := rules.CreateMinimumBandwidthRuleOpts{
+		MinKBps:   1000,
+		Direction: rules.DirectionEgress,
+	}
+	r, err := rules.CreateMinimumBandwidthRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &MinimumBandwidthRule1, r)
+}
+
+func TestUpdateMinimumBandwidthRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_bandwidth_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, MinimumBandwidthRuleUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, MinimumBandwidthRuleGetResult)
+	})
+
+	minKBps := 500
+	opts := rules.UpdateMinimumBandwidthRuleOpts{
+		MinKBps: &minKBps,
+	}
+	_, err := rules.UpdateMinimumBandwidthRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241", opts).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteMinimumBandwidthRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_bandwidth_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := rules.DeleteMinimumBandwidthRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListMinimumPacketRateRules(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"min_kpps": "1000"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, MinimumPacketRateRulesListResult)
+	})
+
+	count := 0
+
+	err := rules.ListMinimumPacketRateRules(
+		fake.ServiceClient(),
+		"501005fa-3b56-4061-aaca-3f24995112e1",
+		rules.MinimumPacketRateRulesListOpts{
+			MinKpps: 1000,
+		},
+	).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := rules.ExtractMinimumPacketRateRules(page)
+		if err != nil {
+			t.Errorf("Failed to extract minimum_packet_rate_rules: %v", err)
+			return false, nil
+		}
+
+		th.CheckDeepEquals(t, []rules.MinimumPacketRateRule{MinimumPacketRateRule1}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGetMinimumPacketRateRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, MinimumPacketRateRuleGetResult)
+	})
+
+	r, err := rules.GetMinimumPacketRateRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &MinimumPacketRateRule1, r)
+}
+
+func TestCreateMinimumPacketRateRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, MinimumPacketRateRuleCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprint(w, MinimumPacketRateRuleGetResult)
+	})
+
+	opts := rules.CreateMinimumPacketRateRuleOpts{
+		MinKpps:   1000,
+		Direction: rules.DirectionAny,
+	}
+	r, err := rules.CreateMinimumPacketRateRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &MinimumPacketRateRule1, r)
+}
+
+func TestUpdateMinimumPacketRateRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, MinimumPacketRateRuleUpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprint(w, MinimumPacketRateRuleGetResult)
+	})
+
+	minKpps := 500
+	opts := rules.UpdateMinimumPacketRateRuleOpts{
+		MinKpps: &minKpps,
+	}
+	_, err := rules.UpdateMinimumPacketRateRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241", opts).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestDeleteMinimumPacketRateRule(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/minimum_packet_rate_rules/30a57f4a-336b-4382-8275-d708babd2241", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := rules.DeleteMinimumPacketRateRule(fake.ServiceClient(), "501005fa-3b56-4061-aaca-3f24995112e1", "30a57f4a-336b-4382-8275-d708babd2241")
+	th.AssertNoErr(t, res.Err)
+}
+
+func handlePaginatedRulesList(t *testing.T, ruleType string) {
+	path := "/v2.0/qos/policies/501005fa-3b56-4061-aaca-3f24995112e1/" + ruleType + "_rules"
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		r.ParseForm()
+		switch marker := r.Form.Get("marker"); marker {
+		case "":
+			links := fmt.Sprintf(`[{"href": "%s?limit=1&marker=30a57f4a-336b-4382-8275-d708babd2241", "rel": "next"}]`, th.Server.URL+path)
+			fmt.Fprintf(w, RulesPaginatedListResult, ruleType, "30a57f4a-336b-4382-8275-d708babd2241", links)
+		case "30a57f4a-336b-4382-8275-d708babd2241":
+			fmt.Fprintf(w, RulesPaginatedListResult, ruleType, "cb1c8d9b-6fa3-4b2c-93a9-19b5b3c8e0b4", "[]")
+		default:
+			t.Fatalf("Unexpected marker: [%s]", marker)
+		}
+	})
+}
+
+func TestListRulesPaginated(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	policyID := "501005fa-3b56-4061-aaca-3f24995112e1"
+	expected := []string{"30a57f4a-336b-4382-8275-d708babd2241", "cb1c8d9b-6fa3-4b2c-93a9-19b5b3c8e0b4"}
+
+	handlePaginatedRulesList(t, "bandwidth_limit")
+	handlePaginatedRulesList(t, "dscp_marking")
+	handlePaginatedRulesList(t, "minimum_bandwidth")
+	handlePaginatedRulesList(t, "minimum_packet_rate")
+
+	allPages, err := rules.ListBandwidthLimitRules(fake.ServiceClient(), policyID, rules.BandwidthLimitRulesListOpts{Limit: 1}).AllPages()
+	th.AssertNoErr(t, err)
+	bandwidthLimitRules, err := rules.ExtractBandwidthLimitRules(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(bandwidthLimitRules))
+	for i, rule := range bandwidthLimitRules {
+		th.AssertEquals(t, expected[i], rule.ID)
+	}
+
+	allPages, err = rules.ListDSCPMarkingRules(fake.ServiceClient(), policyID, rules.DSCPMarkingRulesListOpts{Limit: 1}).AllPages()
+	th.AssertNoErr(t, err)
+	dscpMarkingRules, err := rules.ExtractDSCPMarkingRules(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(dscpMarkingRules))
+	for i, rule := range dscpMarkingRules {
+		th.AssertEquals(t, expected[i], rule.ID)
+	}
+
+	allPages, err = rules.ListMinimumBandwidthRules(fake.ServiceClient(), policyID, rules.MinimumBandwidthRulesListOpts{Limit: 1}).AllPages()
+	th.AssertNoErr(t, err)
+	minimumBandwidthRules, err := rules.ExtractMinimumBandwidthRules(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(minimumBandwidthRules))
+	for i, rule := range minimumBandwidthRules {
+		th.AssertEquals(t, expected[i], rule.ID)
+	}
+
+	allPages, err = rules.ListMinimumPacketRateRules(fake.ServiceClient(), policyID, rules.MinimumPacketRateRulesListOpts{Limit: 1}).AllPages()
+	th.AssertNoErr(t, err)
+	minimumPacketRateRules, err := rules.ExtractMinimumPacketRateRules(allPages)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, len(minimumPacketRateRules))
+	for i, rule := range minimumPacketRateRules {
+		th.AssertEquals(t, expected[i], rule.ID)
+	}
+}
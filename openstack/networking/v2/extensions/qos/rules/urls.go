@@ -0,0 +1,105 @@
+package rules
+
+import "github.com/chjlangzi/gophercloud"
+
+const (
+	rootPath = "qos"
+
+	policiesResourcePath = "policies"
+)
+
+func policyRuleRootURL(c *gophercloud.ServiceClient, policyID, ruleType string) string {
+	return c.ServiceURL(rootPath, policiesResourcePath, policyID, ruleType)
+}
+
+func policyRuleResourceURL(c *gophercloud.ServiceClient, policyID, ruleType, ruleID string) string {
+	return c.ServiceURL(rootPath, policiesResourcePath, policyID, ruleType, ruleID)
+}
+
+const bandwidthLimitRuleResourcePath = "bandwidth_limit_rules"
+
+func listBandwidthLimitRulesURL(c *gophercloud.ServiceClient, policyID string) string {
+	return policyRuleRootURL(c, policyID, bandwidthLimitRuleResourcePath)
+}
+
+func getBandwidthLimitRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, bandwidthLimitRuleResourcePath, ruleID)
+}
+
+func createBandwidthLimitRuleURL(c *gophercloud.ServiceClient, policyID string) string {
+	return policyRuleRootURL(c, policyID, bandwidthLimitRuleResourcePath)
+}
+
+func updateBandwidthLimitRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, bandwidthLimitRuleResourcePath, ruleID)
+}
+
+func deleteBandwidthLimitRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, bandwidthLimitRuleResourcePath, ruleID)
+}
+
+const dscpMarkingRuleResourcePath = "dscp_marking_rules"
+
+func listDSCPMarkingRulesURL(c *gophercloud.ServiceClient, policyID string) string {
+	return policyRuleRootURL(c, policyID, dscpMarkingRuleResourcePath)
+}
+
+func getDSCPMarkingRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, dscpMarkingRuleResourcePath, ruleID)
+}
+
+func createDSCPMarkingRuleURL(c *gophercloud.ServiceClient, policyID string) string {
+	return policyRuleRootURL(c, policyID, dscpMarkingRuleResourcePath)
+}
+
+func updateDSCPMarkingRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, dscpMarkingRuleResourcePath, ruleID)
+}
+
+func deleteDSCPMarkingRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, dscpMarkingRuleResourcePath, ruleID)
+}
+
+const minimumBandwidthRuleResourcePath = "minimum_bandwidth_rules"
+
+func listMinimumBandwidthRulesURL(c *gophercloud.ServiceClient, policyID string) string {
+	return policyRuleRootURL(c, policyID, minimumBandwidthRuleResourcePath)
+}
+
+func getMinimumBandwidthRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, minimumBandwidthRuleResourcePath, ruleID)
+}
+
+func createMinimumBandwidthRuleURL(c *gophercloud.ServiceClient, policyID string) string {
+	return policyRuleRootURL(c, policyID, minimumBandwidthRuleResourcePath)
+}
+
+func updateMinimumBandwidthRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, minimumBandwidthRuleResourcePath, ruleID)
+}
+
+func deleteMinimumBandwidthRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, minimumBandwidthRuleResourcePath, ruleID)
+}
+
+const minimumPacketRateRuleResourcePath = "minimum_packet_rate_rules"
+
+func listMinimumPacketRateRulesURL(c *gophercloud.ServiceClient, policyID string) string {
+	return policyRuleRootURL(c, policyID, minimumPacketRateRuleResourcePath)
+}
+
+func getMinimumPacketRateRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, minimumPacketRateRuleResourcePath, ruleID)
+}
+
+func createMinimumPacketRateRuleURL(c *gophercloud.ServiceClient, policyID string) string {
+	return policyRuleRootURL(c, policyID, minimumPacketRateRuleResourcePath)
+}
+
+func updateMinimumPacketRateRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, minimumPacketRateRuleResourcePath, ruleID)
+}
+
+func deleteMinimumPacketRateRuleURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return policyRuleResourceURL(c, policyID, minimumPacketRateRuleResourcePath, ruleID)
+}
@@ -0,0 +1,75 @@
+/*
+Package quotas provides the ability to retrieve and manage Networking quotas
+through the Neutron API.
+
+Example to Get project quotas
+
+	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	quotasInfo, err := quotas.Get(networkClient, projectID).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("quotas: %#v\n", quotasInfo)
+
+Example to Get the default quotas
+
+	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	defaults, err := quotas.GetDefaults(networkClient, projectID).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+Example to Get the detailed quotas of a project
+
+	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	details, err := quotas.GetDetail(networkClient, projectID).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("ports: %d used, %d reserved of %d\n",
+		details.Port.Used, details.Port.Reserved, details.Port.Limit)
+
+Example to Update project quotas
+
+	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+
+	updateOpts := quotas.UpdateOpts{
+		FloatingIP:        gophercloud.IntToPointer(0),
+		Network:           gophercloud.IntToPointer(-1),
+		Port:              gophercloud.IntToPointer(5),
+		Router:            gophercloud.IntToPointer(15),
+		SecurityGroupRule: gophercloud.IntToPointer(-1),
+	}
+	quotasInfo, err := quotas.Update(networkClient, projectID, updateOpts).Extract()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+Example to reset project quotas to their defaults
+
+	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	err := quotas.Delete(networkClient, projectID).ExtractErr()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+Example to check the headroom of a project before provisioning
+
+	projectID = "23d5d3f79dfa4f73b72b8b0b0063ec55"
+	headroom, err := quotas.GetHeadroom(networkClient, projectID)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = quotas.CheckHeadroom(headroom, map[string]int{
+		quotas.ResourceNetworks:    1,
+		quotas.ResourcePorts:       3,
+		quotas.ResourceFloatingIPs: 1,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+*/
+package quotas
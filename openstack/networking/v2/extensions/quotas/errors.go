@@ -0,0 +1,20 @@
+package quotas
+
+import (
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrInsufficientHeadroom is returned by CheckHeadroom when a project does
+// not have enough quota left for a resource.
+type ErrInsufficientHeadroom struct {
+	gophercloud.BaseError
+	Resource  string
+	Requested int
+	Available int
+}
+
+func (e ErrInsufficientHeadroom) Error() string {
+	return fmt.Sprintf("Not enough %s quota left: requested %d, available %d", e.Resource, e.Requested, e.Available)
+}
@@ -0,0 +1,132 @@
+package quotas
+
+import "github.com/chjlangzi/gophercloud"
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Quota resource.
+func (r commonResult) Extract() (*Quota, error) {
+	var s struct {
+		Quota *Quota `json:"quota"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quota, err
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Quota.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a Quota.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// GetDetailResult represents the result of a get detail operation. Call its
+// Extract method to interpret it as a QuotaDetailSet.
+type GetDetailResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a QuotaDetailSet
+// resource.
+func (r GetDetailResult) Extract() (*QuotaDetailSet, error) {
+	var s struct {
+		Quota *QuotaDetailSet `json:"quota"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Quota, err
+}
+
+// Quota contains Networking quotas for a project.
+type Quota struct {
+	// FloatingIP represents a number of floating IPs. A "-1" value means no limit.
+	FloatingIP int `json:"floatingip"`
+
+	// Network represents a number of networks. A "-1" value means no limit.
+	Network int `json:"network"`
+
+	// Port represents a number of ports. A "-1" value means no limit.
+	Port int `json:"port"`
+
+	// RBACPolicy represents a number of RBAC policies. A "-1" value means no limit.
+	RBACPolicy int `json:"rbac_policy"`
+
+	// Router represents a number of routers. A "-1" value means no limit.
+	Router int `json:"router"`
+
+	// SecurityGroup represents a number of security groups. A "-1" value means no limit.
+	SecurityGroup int `json:"security_group"`
+
+	// SecurityGroupRule represents a number of security group rules. A "-1" value means no limit.
+	SecurityGroupRule int `json:"security_group_rule"`
+
+	// Subnet represents a number of subnets. A "-1" value means no limit.
+	Subnet int `json:"subnet"`
+
+	// SubnetPool represents a number of subnet pools. A "-1" value means no limit.
+	SubnetPool int `json:"subnetpool"`
+
+	// Trunk represents a number of trunks. A "-1" value means no limit.
+	Trunk int `json:"trunk"`
+}
+
+// QuotaDetailSet represents the detailed Networking quotas of a project.
+type QuotaDetailSet struct {
+	// FloatingIP represents the floating IP usage information.
+	FloatingIP QuotaDetail `json:"floatingip"`
+
+	// Network represents the network usage information.
+	Network QuotaDetail `json:"network"`
+
+	// Port represents the port usage information.
+	Port QuotaDetail `json:"port"`
+
+	// RBACPolicy represents the RBAC policy usage information.
+	RBACPolicy QuotaDetail `json:"rbac_policy"`
+
+	// Router represents the router usage information.
+	Router QuotaDetail `json:"router"`
+
+	// SecurityGroup represents the security group usage information.
+	SecurityGroup QuotaDetail `json:"security_group"`
+
+	// SecurityGroupRule represents the security group rule usage information.
+	SecurityGroupRule QuotaDetail `json:"security_group_rule"`
+
+	// Subnet represents the subnet usage information.
+	Subnet QuotaDetail `json:"subnet"`
+
+	// SubnetPool represents the subnet pool usage information.
+	SubnetPool QuotaDetail `json:"subnetpool"`
+
+	// Trunk represents the trunk usage information.
+	Trunk QuotaDetail `json:"trunk"`
+}
+
+// QuotaDetail is a set of details about a single operational limit that
+// allows for control of networking usage.
+type QuotaDetail struct {
+	// Used is the current number of provisioned/allocated resources of the
+	// given type.
+	Used int `json:"used"`
+
+	// Reserved is a transitional state when a claim against quota has been
+	// made but the resource is not yet fully online.
+	Reserved int `json:"reserved"`
+
+	// Limit is the maximum number of a given resource that can be
+	// allocated/provisioned. This is what "quota" usually refers to.
+	Limit int `json:"limit"`
+}
@@ -0,0 +1,93 @@
+package testing
+
+import "github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/quotas"
+
+const GetResponseRaw = `
+{
+    "quota": {
+        "floatingip": 15,
+        "network": 20,
+        "port": 25,
+        "rbac_policy": -1,
+        "router": 30,
+        "security_group": 35,
+        "security_group_rule": 40,
+        "subnet": 45,
+        "subnetpool": -1,
+        "trunk": 50
+    }
+}
+`
+
+const GetDetailResponseRaw = `
+{
+    "quota": {
+        "floatingip": {"used": 3, "limit": 15, "reserved": 0},
+        "network": {"used": 20, "limit": 20, "reserved": 0},
+        "port": {"used": 20, "limit": 25, "reserved": 1},
+        "rbac_policy": {"used": 0, "limit": -1, "reserved": 0},
+        "router": {"used": 1, "limit": 30, "reserved": 0},
+        "security_group": {"used": 2, "limit": 35, "reserved": 0},
+        "security_group_rule": {"used": 10, "limit": -1, "reserved": 0},
+        "subnet": {"used": 5, "limit": 45, "reserved": 0},
+        "subnetpool": {"used": 0, "limit": -1, "reserved": 0},
+        "trunk": {"used": 0, "limit": 50, "reserved": 0}
+    }
+}
+`
+
+const UpdateRequestResponseRaw = `
+{
+    "quota": {
+        "floatingip": 0,
+        "network": -1,
+        "port": 5,
+        "rbac_policy": 10,
+        "router": 15,
+        "security_group": 20,
+        "security_group_rule": -1,
+        "subnet": 25,
+        "subnetpool": 0,
+        "trunk": 5
+    }
+}
+`
+
+var GetResponse = quotas.Quota{
+	FloatingIP:        15,
+	Network:           20,
+	Port:              25,
+	RBACPolicy:        -1,
+	Router:            30,
+	SecurityGroup:     35,
+	SecurityGroupRule: 40,
+	Subnet:            45,
+	SubnetPool:        -1,
+	Trunk:             50,
+}
+
+var GetDetailResponse = quotas.QuotaDetailSet{
+	FloatingIP:        quotas.QuotaDetail{Used: 3, Limit: 15},
+	Network:           quotas.QuotaDetail{Used: 20, Limit: 20},
+	Port:              quotas.QuotaDetail{Used: 20, Limit: 25, Reserved: 1},
+	RBACPolicy:        quotas.QuotaDetail{Limit: -1},
+	Router:            quotas.QuotaDetail{Used: 1, Limit: 30},
+	SecurityGroup:     quotas.QuotaDetail{Used: 2, Limit: 35},
+	SecurityGroupRule: quotas.QuotaDetail{Used: 10, Limit: -1},
+	Subnet:            quotas.QuotaDetail{Used: 5, Limit: 45},
+	SubnetPool:        quotas.QuotaDetail{Limit: -1},
+	Trunk:             quotas.QuotaDetail{Limit: 50},
+}
+
+var UpdateResponse = quotas.Quota{
+	FloatingIP:        0,
+	Network:           -1,
+	Port:              5,
+	RBACPolicy:        10,
+	Router:            15,
+	SecurityGroup:     20,
+	SecurityGroupRule: -1,
+	Subnet:            25,
+	SubnetPool:        0,
+	Trunk:             5,
+}
@@ -0,0 +1,166 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud"
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/quotas"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+const projectID = "0a73845280574ad389c292f6a74afa76"
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponseRaw)
+	})
+
+	q, err := quotas.Get(fake.ServiceClient(), projectID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &GetResponse, q)
+}
+
+func TestGetDefaults(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+projectID+"/default", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponseRaw)
+	})
+
+	q, err := quotas.GetDefaults(fake.ServiceClient(), projectID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &GetResponse, q)
+}
+
+func TestGetDetail(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+projectID+"/details.json", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetDetailResponseRaw)
+	})
+
+	q, err := quotas.GetDetail(fake.ServiceClient(), projectID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &GetDetailResponse, q)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequestResponseRaw)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateRequestResponseRaw)
+	})
+
+	q, err := quotas.Update(fake.ServiceClient(), projectID, quotas.UpdateOpts{
+		FloatingIP:        gophercloud.IntToPointer(0),
+		Network:           gophercloud.IntToPointer(-1),
+		Port:              gophercloud.IntToPointer(5),
+		RBACPolicy:        gophercloud.IntToPointer(10),
+		Router:            gophercloud.IntToPointer(15),
+		SecurityGroup:     gophercloud.IntToPointer(20),
+		SecurityGroupRule: gophercloud.IntToPointer(-1),
+		Subnet:            gophercloud.IntToPointer(25),
+		SubnetPool:        gophercloud.IntToPointer(0),
+		Trunk:             gophercloud.IntToPointer(5),
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &UpdateResponse, q)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+projectID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	err := quotas.Delete(fake.ServiceClient(), projectID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestCalculateHeadroom(t *testing.T) {
+	headroom := quotas.CalculateHeadroom(GetDetailResponse)
+
+	th.AssertDeepEquals(t, map[string]quotas.Headroom{
+		quotas.ResourceNetworks:           {Limit: 20, Used: 20, Available: 0},
+		quotas.ResourcePorts:              {Limit: 25, Used: 20, Reserved: 1, Available: 4},
+		quotas.ResourceFloatingIPs:        {Limit: 15, Used: 3, Available: 12},
+		quotas.ResourceRouters:            {Limit: 30, Used: 1, Available: 29},
+		quotas.ResourceSecurityGroupRules: {Limit: -1, Used: 10, Available: -1},
+	}, headroom)
+}
+
+func TestGetHeadroomAndCheck(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/quotas/"+projectID+"/details.json", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetDetailResponseRaw)
+	})
+
+	headroom, err := quotas.GetHeadroom(fake.ServiceClient(), projectID)
+	th.AssertNoErr(t, err)
+
+	err = quotas.CheckHeadroom(headroom, map[string]int{
+		quotas.ResourcePorts:              4,
+		quotas.ResourceFloatingIPs:        12,
+		quotas.ResourceSecurityGroupRules: 1000,
+	})
+	th.AssertNoErr(t, err)
+
+	err = quotas.CheckHeadroom(headroom, map[string]int{
+		quotas.ResourcePorts:    5,
+		quotas.ResourceNetworks: 1,
+	})
+	e, ok := err.(quotas.ErrInsufficientHeadroom)
+	if !ok {
+		t.Fatalf("Expected ErrInsufficientHeadroom, got %v", err)
+	}
+	th.AssertEquals(t, quotas.ResourceNetworks, e.Resource)
+	th.AssertEquals(t, 1, e.Requested)
+	th.AssertEquals(t, 0, e.Available)
+}
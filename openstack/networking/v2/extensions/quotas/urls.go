@@ -0,0 +1,29 @@
+package quotas
+
+import "github.com/chjlangzi/gophercloud"
+
+const resourcePath = "quotas"
+
+func resourceURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(resourcePath, projectID)
+}
+
+func getURL(c *gophercloud.ServiceClient, projectID string) string {
+	return resourceURL(c, projectID)
+}
+
+func getDefaultsURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(resourcePath, projectID, "default")
+}
+
+func getDetailURL(c *gophercloud.ServiceClient, projectID string) string {
+	return c.ServiceURL(resourcePath, projectID, "details.json")
+}
+
+func updateURL(c *gophercloud.ServiceClient, projectID string) string {
+	return resourceURL(c, projectID)
+}
+
+func deleteURL(c *gophercloud.ServiceClient, projectID string) string {
+	return resourceURL(c, projectID)
+}
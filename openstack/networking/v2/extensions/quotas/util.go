@@ -0,0 +1,118 @@
+package quotas
+
+import (
+	"sort"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// Keys of the map returned by CalculateHeadroom. They are the singular
+// resource names Neutron uses in its quota bodies, not the plural ones used
+// by the Compute service.
+const (
+	ResourceNetworks           = "network"
+	ResourcePorts              = "port"
+	ResourceFloatingIPs        = "floatingip"
+	ResourceRouters            = "router"
+	ResourceSecurityGroupRules = "security_group_rule"
+)
+
+// Headroom is the remaining capacity of a single Neutron quota resource, as
+// derived from the quota-details extension.
+type Headroom struct {
+	// Limit is the project's quota for the resource, -1 meaning unlimited.
+	// Neutron has no separate limits API, so this is taken as is.
+	Limit int
+
+	// Used is the number of resources the project owns, reported by Neutron
+	// as "used" rather than the Compute service's "in_use".
+	Used int
+
+	// Reserved counts resources Neutron has reserved for create requests
+	// still being processed. It is usually zero outside of bulk creation.
+	Reserved int
+
+	// Available is Limit minus Used and Reserved, never below zero, or -1
+	// if the resource is unlimited.
+	Available int
+}
+
+// Unlimited reports whether the project has no quota for the resource.
+func (h Headroom) Unlimited() bool {
+	return h.Limit < 0
+}
+
+// CalculateHeadroom returns the headroom of networks, ports, floating IPs,
+// routers and security group rules from the detailed quotas of a project.
+// Unlike quotasets.CalculateHeadroom in the Compute service, there is nothing
+// to reconcile the quota details against: they are the only source of both
+// limits and usage.
+func CalculateHeadroom(detail QuotaDetailSet) map[string]Headroom {
+	return map[string]Headroom{
+		ResourceNetworks:           headroom(detail.Network),
+		ResourcePorts:              headroom(detail.Port),
+		ResourceFloatingIPs:        headroom(detail.FloatingIP),
+		ResourceRouters:            headroom(detail.Router),
+		ResourceSecurityGroupRules: headroom(detail.SecurityGroupRule),
+	}
+}
+
+func headroom(detail QuotaDetail) Headroom {
+	h := Headroom{
+		Limit:    detail.Limit,
+		Used:     detail.Used,
+		Reserved: detail.Reserved,
+	}
+
+	if h.Unlimited() {
+		h.Available = -1
+		return h
+	}
+
+	h.Available = h.Limit - h.Used - h.Reserved
+	if h.Available < 0 {
+		h.Available = 0
+	}
+
+	return h
+}
+
+// GetHeadroom retrieves the detailed quotas of a project and returns the
+// headroom of each resource.
+func GetHeadroom(client *gophercloud.ServiceClient, projectID string) (map[string]Headroom, error) {
+	detail, err := GetDetail(client, projectID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return CalculateHeadroom(*detail), nil
+}
+
+// CheckHeadroom verifies that the headroom allows the requested amount of
+// each resource, keyed by the Resource* constants. It returns an
+// ErrInsufficientHeadroom for the first resource, in alphabetical order,
+// which does not fit. Resources missing from the headroom are ignored.
+func CheckHeadroom(headroom map[string]Headroom, requested map[string]int) error {
+	resources := make([]string, 0, len(requested))
+	for resource := range requested {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		h, ok := headroom[resource]
+		if !ok || h.Unlimited() {
+			continue
+		}
+
+		if requested[resource] > h.Available {
+			return ErrInsufficientHeadroom{
+				Resource:  resource,
+				Requested: requested[resource],
+				Available: h.Available,
+			}
+		}
+	}
+
+	return nil
+}
@@ -11,14 +11,18 @@ import (
 // sort by a particular network attribute. SortDir sets the direction, and is
 // either `asc' or `desc'. Marker and Limit are used for pagination.
 type ListOpts struct {
-	ID        string `q:"id"`
-	Name      string `q:"name"`
-	TenantID  string `q:"tenant_id"`
-	ProjectID string `q:"project_id"`
-	Limit     int    `q:"limit"`
-	Marker    string `q:"marker"`
-	SortKey   string `q:"sort_key"`
-	SortDir   string `q:"sort_dir"`
+	ID         string `q:"id"`
+	Name       string `q:"name"`
+	TenantID   string `q:"tenant_id"`
+	ProjectID  string `q:"project_id"`
+	Limit      int    `q:"limit"`
+	Marker     string `q:"marker"`
+	SortKey    string `q:"sort_key"`
+	SortDir    string `q:"sort_dir"`
+	Tags       string `q:"tags"`
+	TagsAny    string `q:"tags-any"`
+	NotTags    string `q:"not-tags"`
+	NotTagsAny string `q:"not-tags-any"`
 }
 
 // List returns a Pager which allows you to iterate over a collection of
@@ -0,0 +1,75 @@
+/*
+Package segments provides the ability to retrieve and manage network segments
+through the Neutron API. Segments are used by routed provider networks, where
+each subnet of a network is bound to one of its segments through the
+SegmentID attribute of the subnets package.
+
+Example of Listing the segments of a network
+
+	listOpts := segments.ListOpts{
+		NetworkID: "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+	}
+
+	allPages, err := segments.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allSegments, err := segments.ExtractSegments(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, segment := range allSegments {
+		fmt.Printf("%+v\n", segment)
+	}
+
+Example of Creating a segment
+
+	createOpts := segments.CreateOpts{
+		NetworkID:       "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "segment-2",
+		SegmentationID:  2016,
+		Name:            "rack-2",
+	}
+
+	segment, err := segments.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Creating a subnet on a segment
+
+	subnetOpts := subnets.CreateOpts{
+		NetworkID: "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+		IPVersion: 4,
+		CIDR:      "203.0.113.0/24",
+		SegmentID: segment.ID,
+	}
+
+	subnet, err := subnets.Create(networkClient, subnetOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Updating a segment
+
+	description := "second rack"
+	updateOpts := segments.UpdateOpts{
+		Description: &description,
+	}
+
+	segment, err := segments.Update(networkClient, segmentID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Deleting a segment
+
+	err := segments.Delete(networkClient, segmentID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package segments
@@ -0,0 +1,147 @@
+package segments
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToSegmentListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the segment attributes you want to see returned. SortKey allows you to sort
+// by a particular segment attribute. SortDir sets the direction, and is either
+// `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	ID              string `q:"id"`
+	NetworkID       string `q:"network_id"`
+	Name            string `q:"name"`
+	Description     string `q:"description"`
+	PhysicalNetwork string `q:"physical_network"`
+	NetworkType     string `q:"network_type"`
+	SegmentationID  int    `q:"segmentation_id"`
+	RevisionNumber  *int   `q:"revision_number"`
+	Limit           int    `q:"limit"`
+	Marker          string `q:"marker"`
+	SortKey         string `q:"sort_key"`
+	SortDir         string `q:"sort_dir"`
+}
+
+// ToSegmentListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToSegmentListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// segments. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToSegmentListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return SegmentPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific segment based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToSegmentCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents the attributes used when creating a new segment.
+type CreateOpts struct {
+	// NetworkID is the ID of the network the segment belongs to.
+	NetworkID string `json:"network_id" required:"true"`
+
+	// NetworkType is the type of physical network, e.g. flat, vlan, vxlan or
+	// geneve.
+	NetworkType string `json:"network_type" required:"true"`
+
+	// PhysicalNetwork is the physical network where the segment is
+	// implemented. It is required by flat and vlan segments.
+	PhysicalNetwork string `json:"physical_network,omitempty"`
+
+	// SegmentationID is the ID of the isolated segment on the physical
+	// network, e.g. the VLAN ID.
+	SegmentationID int `json:"segmentation_id,omitempty"`
+
+	// Name is a human-readable name of the segment.
+	Name string `json:"name,omitempty"`
+
+	// Description is a human-readable description of the segment.
+	Description string `json:"description,omitempty"`
+}
+
+// ToSegmentCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToSegmentCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "segment")
+}
+
+// Create accepts a CreateOpts struct and creates a new segment using the
+// values provided.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToSegmentCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c), b, &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSegmentUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents the attributes used when updating an existing
+// segment. Only the name and the description of a segment can be changed.
+type UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToSegmentUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToSegmentUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "segment")
+}
+
+// Update accepts an UpdateOpts struct and updates an existing segment using
+// the values provided.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSegmentUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the segment associated with it.
+// A segment can not be deleted while subnets are associated with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return
+}
@@ -0,0 +1,119 @@
+package segments
+
+import (
+	"time"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Segment.
+func (r commonResult) Extract() (*Segment, error) {
+	var s struct {
+		Segment *Segment `json:"segment"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Segment, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Segment.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Segment.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a Segment.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// Segment represents a segment of a network. A network with more than one
+// segment is a routed provider network, where each subnet belongs to a
+// single segment.
+type Segment struct {
+	// ID is the unique ID of the segment.
+	ID string `json:"id"`
+
+	// NetworkID is the ID of the network the segment belongs to.
+	NetworkID string `json:"network_id"`
+
+	// Name is a human-readable name of the segment.
+	Name string `json:"name"`
+
+	// Description is a human-readable description of the segment.
+	Description string `json:"description"`
+
+	// PhysicalNetwork is the physical network where the segment is
+	// implemented.
+	PhysicalNetwork string `json:"physical_network"`
+
+	// NetworkType is the type of physical network, e.g. flat, vlan, vxlan or
+	// geneve.
+	NetworkType string `json:"network_type"`
+
+	// SegmentationID is the ID of the isolated segment on the physical
+	// network. It is zero for flat segments.
+	SegmentationID int `json:"segmentation_id"`
+
+	// RevisionNumber is the revision number of the segment.
+	RevisionNumber int `json:"revision_number"`
+
+	// CreatedAt is the time at which the segment has been created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time at which the segment has been updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SegmentPage is the page returned by a pager when traversing over a
+// collection of segments.
+type SegmentPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of segments has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r SegmentPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"segments_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a SegmentPage struct is empty.
+func (r SegmentPage) IsEmpty() (bool, error) {
+	is, err := ExtractSegments(r)
+	return len(is) == 0, err
+}
+
+// ExtractSegments accepts a Page struct, specifically a SegmentPage struct,
+// and extracts the elements into a slice of Segment structs.
+func ExtractSegments(r pagination.Page) ([]Segment, error) {
+	var s struct {
+		Segments []Segment `json:"segments"`
+	}
+	err := (r.(SegmentPage)).ExtractInto(&s)
+	return s.Segments, err
+}
@@ -0,0 +1,2 @@
+// segments unit tests
+package testing
@@ -0,0 +1,114 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/segments"
+)
+
+const ListResponse = `
+{
+    "segments": [
+        {
+            "id": "62b32572-3ba3-4b69-9c2c-0b0fae46ee0a",
+            "network_id": "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+            "name": null,
+            "description": null,
+            "network_type": "flat",
+            "physical_network": "segment-1",
+            "segmentation_id": null,
+            "revision_number": 1,
+            "created_at": "2018-11-08T09:30:57Z",
+            "updated_at": "2018-11-08T09:30:57Z"
+        },
+        {
+            "id": "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4",
+            "network_id": "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+            "name": "rack-2",
+            "description": "",
+            "network_type": "vlan",
+            "physical_network": "segment-2",
+            "segmentation_id": 2016,
+            "revision_number": 3,
+            "created_at": "2018-11-08T09:31:12Z",
+            "updated_at": "2018-11-08T09:31:12Z"
+        }
+    ]
+}
+`
+
+const CreateRequest = `
+{
+    "segment": {
+        "network_id": "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+        "network_type": "vlan",
+        "physical_network": "segment-2",
+        "segmentation_id": 2016,
+        "name": "rack-2"
+    }
+}
+`
+
+const SegmentResponse = `
+{
+    "segment": {
+        "id": "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4",
+        "network_id": "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+        "name": "rack-2",
+        "description": "",
+        "network_type": "vlan",
+        "physical_network": "segment-2",
+        "segmentation_id": 2016,
+        "revision_number": 3,
+        "created_at": "2018-11-08T09:31:12Z",
+        "updated_at": "2018-11-08T09:31:12Z"
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "segment": {
+        "description": "second rack"
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "segment": {
+        "id": "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4",
+        "network_id": "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+        "name": "rack-2",
+        "description": "second rack",
+        "network_type": "vlan",
+        "physical_network": "segment-2",
+        "segmentation_id": 2016,
+        "revision_number": 4,
+        "created_at": "2018-11-08T09:31:12Z",
+        "updated_at": "2018-11-08T09:35:40Z"
+    }
+}
+`
+
+var Segment1 = segments.Segment{
+	ID:              "62b32572-3ba3-4b69-9c2c-0b0fae46ee0a",
+	NetworkID:       "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+	NetworkType:     "flat",
+	PhysicalNetwork: "segment-1",
+	RevisionNumber:  1,
+	CreatedAt:       time.Date(2018, 11, 8, 9, 30, 57, 0, time.UTC),
+	UpdatedAt:       time.Date(2018, 11, 8, 9, 30, 57, 0, time.UTC),
+}
+
+var Segment2 = segments.Segment{
+	ID:              "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4",
+	NetworkID:       "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+	Name:            "rack-2",
+	NetworkType:     "vlan",
+	PhysicalNetwork: "segment-2",
+	SegmentationID:  2016,
+	RevisionNumber:  3,
+	CreatedAt:       time.Date(2018, 11, 8, 9, 31, 12, 0, time.UTC),
+	UpdatedAt:       time.Date(2018, 11, 8, 9, 31, 12, 0, time.UTC),
+}
@@ -0,0 +1,143 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/segments"
+	"github.com/chjlangzi/gophercloud/pagination"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"network_id": "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	count := 0
+
+	err := segments.List(fake.ServiceClient(), segments.ListOpts{NetworkID: "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5"}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := segments.ExtractSegments(page)
+		if err != nil {
+			t.Errorf("Failed to extract segments: %v", err)
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, []segments.Segment{Segment1, Segment2}, actual)
+
+		return true, nil
+	})
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SegmentResponse)
+	})
+
+	s, err := segments.Get(fake.ServiceClient(), "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Segment2, s)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, SegmentResponse)
+	})
+
+	opts := segments.CreateOpts{
+		NetworkID:       "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5",
+		NetworkType:     "vlan",
+		PhysicalNetwork: "segment-2",
+		SegmentationID:  2016,
+		Name:            "rack-2",
+	}
+	s, err := segments.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Segment2, s)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := segments.Create(fake.ServiceClient(), segments.CreateOpts{NetworkID: "6e4de47a-20e4-4c3f-9b41-09aa5cb6c9a5"})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateResponse)
+	})
+
+	description := "second rack"
+	s, err := segments.Update(fake.ServiceClient(), "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4", segments.UpdateOpts{
+		Description: &description,
+	}).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, description, s.Description)
+	th.AssertEquals(t, 4, s.RevisionNumber)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/segments/70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := segments.Delete(fake.ServiceClient(), "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4")
+	th.AssertNoErr(t, res.Err)
+}
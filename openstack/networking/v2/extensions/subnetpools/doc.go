@@ -68,5 +68,39 @@ Example to Delete a Subnetpool
 	if err != nil {
 		panic(err)
 	}
+
+Example to Add Prefixes to a Subnetpool
+
+	subnetPoolID := "099546ca-788d-41e5-a76d-17d8cd282d3e"
+	prefixesOpts := subnetpools.PrefixesOpts{
+		Prefixes: []string{"10.13.0.0/16"},
+	}
+
+	prefixes, err := subnetpools.AddPrefixes(networkClient, subnetPoolID, prefixesOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Onboard the Subnets of a Network into a Subnetpool
+
+	subnetPoolID := "099546ca-788d-41e5-a76d-17d8cd282d3e"
+	onboardOpts := subnetpools.OnboardNetworkSubnetsOpts{
+		NetworkID: "8d4c70a8-a2d7-4ed9-b4f5-6f6a6a3bc8ea",
+	}
+
+	onboarded, err := subnetpools.OnboardNetworkSubnets(networkClient, subnetPoolID, onboardOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Preview the Next Free Prefix of a Subnetpool
+
+	subnetPoolID := "099546ca-788d-41e5-a76d-17d8cd282d3e"
+	prefix, err := subnetpools.FindNextFreePrefix(networkClient, subnetPoolID, 26)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Next /26 prefix: %s\n", prefix)
 */
 package subnetpools
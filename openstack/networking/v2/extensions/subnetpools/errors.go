@@ -0,0 +1,19 @@
+package subnetpools
+
+import (
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrNoFreePrefix is returned by NextFreePrefix and FindNextFreePrefix when
+// no prefix of the requested length is left in a subnetpool.
+type ErrNoFreePrefix struct {
+	gophercloud.BaseError
+	SubnetPoolID string
+	PrefixLen    int
+}
+
+func (e ErrNoFreePrefix) Error() string {
+	return fmt.Sprintf("No free /%d prefix left in subnetpool %s", e.PrefixLen, e.SubnetPoolID)
+}
@@ -36,6 +36,10 @@ type ListOpts struct {
 	Marker           string `q:"marker"`
 	SortKey          string `q:"sort_key"`
 	SortDir          string `q:"sort_dir"`
+	Tags             string `q:"tags"`
+	TagsAny          string `q:"tags-any"`
+	NotTags          string `q:"not-tags"`
+	NotTagsAny       string `q:"not-tags-any"`
 }
 
 // ToSubnetPoolListQuery formats a ListOpts into a query string.
@@ -219,3 +223,85 @@ func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
 	_, r.Err = c.Delete(deleteURL(c, id), nil)
 	return
 }
+
+// PrefixesOptsBuilder allows extensions to add additional parameters to the
+// AddPrefixes and RemovePrefixes requests.
+type PrefixesOptsBuilder interface {
+	ToSubnetPoolPrefixesMap() (map[string]interface{}, error)
+}
+
+// PrefixesOpts represents the prefixes to add to or to remove from a
+// subnetpool.
+type PrefixesOpts struct {
+	// Prefixes is the list of subnet prefixes to add or to remove.
+	Prefixes []string `json:"prefixes" required:"true"`
+}
+
+// ToSubnetPoolPrefixesMap builds a request body from PrefixesOpts.
+func (opts PrefixesOpts) ToSubnetPoolPrefixesMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// AddPrefixes adds the given prefixes to an existing subnetpool. Unlike
+// Update, which replaces the whole list of prefixes, it only appends to it.
+// Neutron merges adjacent prefixes, so the returned list may differ from
+// the requested one.
+func AddPrefixes(c *gophercloud.ServiceClient, subnetPoolID string, opts PrefixesOptsBuilder) (r PrefixesResult) {
+	b, err := opts.ToSubnetPoolPrefixesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(addPrefixesURL(c, subnetPoolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// RemovePrefixes removes the given prefixes from an existing subnetpool.
+// Prefixes which are in use by subnets can not be removed.
+func RemovePrefixes(c *gophercloud.ServiceClient, subnetPoolID string, opts PrefixesOptsBuilder) (r PrefixesResult) {
+	b, err := opts.ToSubnetPoolPrefixesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(removePrefixesURL(c, subnetPoolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// OnboardNetworkSubnetsOptsBuilder allows extensions to add additional
+// parameters to the OnboardNetworkSubnets request.
+type OnboardNetworkSubnetsOptsBuilder interface {
+	ToSubnetPoolOnboardNetworkSubnetsMap() (map[string]interface{}, error)
+}
+
+// OnboardNetworkSubnetsOpts represents the network whose subnets should be
+// onboarded into a subnetpool.
+type OnboardNetworkSubnetsOpts struct {
+	// NetworkID is the ID of the network whose subnets to onboard.
+	NetworkID string `json:"network_id" required:"true"`
+}
+
+// ToSubnetPoolOnboardNetworkSubnetsMap builds a request body from
+// OnboardNetworkSubnetsOpts.
+func (opts OnboardNetworkSubnetsOpts) ToSubnetPoolOnboardNetworkSubnetsMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// OnboardNetworkSubnets moves the subnets of a network, which match the IP
+// version of the subnetpool, into the subnetpool. Their CIDRs are added to
+// the prefixes of the subnetpool.
+func OnboardNetworkSubnets(c *gophercloud.ServiceClient, subnetPoolID string, opts OnboardNetworkSubnetsOptsBuilder) (r OnboardNetworkSubnetsResult) {
+	b, err := opts.ToSubnetPoolOnboardNetworkSubnetsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(onboardNetworkSubnetsURL(c, subnetPoolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
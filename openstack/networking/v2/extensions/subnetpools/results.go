@@ -47,6 +47,48 @@ type DeleteResult struct {
 	gophercloud.ErrResult
 }
 
+// PrefixesResult represents the result of an AddPrefixes or RemovePrefixes
+// operation. Call its Extract method to interpret it as the resulting list of
+// prefixes of the subnetpool.
+type PrefixesResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the prefixes of
+// a subnetpool.
+func (r PrefixesResult) Extract() ([]string, error) {
+	var s struct {
+		Prefixes []string `json:"prefixes"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Prefixes, err
+}
+
+// OnboardNetworkSubnetsResult represents the result of an
+// OnboardNetworkSubnets operation. Call its Extract method to interpret it
+// as a list of OnboardedSubnet.
+type OnboardNetworkSubnetsResult struct {
+	gophercloud.Result
+}
+
+// OnboardedSubnet represents a subnet which has been moved into a
+// subnetpool.
+type OnboardedSubnet struct {
+	// ID is the id of the subnet.
+	ID string `json:"id"`
+
+	// CIDR is the CIDR of the subnet.
+	CIDR string `json:"cidr"`
+}
+
+// Extract is a function that accepts a result and extracts the onboarded
+// subnets.
+func (r OnboardNetworkSubnetsResult) Extract() ([]OnboardedSubnet, error) {
+	var s []OnboardedSubnet
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
 // SubnetPool represents a Neutron subnetpool.
 // A subnetpool is a pool of addresses from which subnets can be allocated.
 type SubnetPool struct {
@@ -111,6 +153,9 @@ type SubnetPool struct {
 
 	// RevisionNumber is the revision number of the subnetpool.
 	RevisionNumber int `json:"revision_number"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
 }
 
 func (r *SubnetPool) UnmarshalJSON(b []byte) error {
@@ -257,3 +257,91 @@ const SubnetPoolUpdateResponse = `
     }
 }
 `
+
+const SubnetPoolAddPrefixesRequest = `
+{
+    "prefixes": [
+        "10.13.0.0/16"
+    ]
+}
+`
+
+const SubnetPoolAddPrefixesResponse = `
+{
+    "prefixes": [
+        "10.8.0.0/16",
+        "10.11.12.0/24",
+        "10.13.0.0/16"
+    ]
+}
+`
+
+const SubnetPoolRemovePrefixesRequest = `
+{
+    "prefixes": [
+        "10.11.12.0/24"
+    ]
+}
+`
+
+const SubnetPoolRemovePrefixesResponse = `
+{
+    "prefixes": [
+        "10.8.0.0/16",
+        "10.13.0.0/16"
+    ]
+}
+`
+
+const SubnetPoolOnboardNetworkSubnetsRequest = `
+{
+    "network_id": "8d4c70a8-a2d7-4ed9-b4f5-6f6a6a3bc8ea"
+}
+`
+
+const SubnetPoolOnboardNetworkSubnetsResponse = `
+[
+    {
+        "id": "a64d1c45-c22f-4f57-9e6b-41f6e3c25c8b",
+        "cidr": "10.13.4.0/24"
+    }
+]
+`
+
+const SubnetPoolGetPrefixesResult = `
+{
+    "subnetpool": {
+        "id": "2b4a4e3a-0b43-44b6-8a4d-b6b4a7c3a56e",
+        "name": "pool",
+        "ip_version": 4,
+        "prefixes": [
+            "10.1.0.0/24",
+            "10.0.0.0/24"
+        ],
+        "default_prefixlen": "26",
+        "min_prefixlen": "24",
+        "max_prefixlen": "28"
+    }
+}
+`
+
+const SubnetsInPoolListResult = `
+{
+    "subnets": [
+        {
+            "id": "7d73c09d-07d0-4a7a-9b48-1cbe25cc47bd",
+            "network_id": "8d4c70a8-a2d7-4ed9-b4f5-6f6a6a3bc8ea",
+            "ip_version": 4,
+            "cidr": "10.0.0.0/26",
+            "subnetpool_id": "2b4a4e3a-0b43-44b6-8a4d-b6b4a7c3a56e"
+        },
+        {
+            "id": "b5a9b2d4-3b5e-4f4b-8d4c-2d4c1b1f1c9a",
+            "network_id": "8d4c70a8-a2d7-4ed9-b4f5-6f6a6a3bc8ea",
+            "ip_version": 4,
+            "cidr": "10.0.0.64/27",
+            "subnetpool_id": "2b4a4e3a-0b43-44b6-8a4d-b6b4a7c3a56e"
+        }
+    ]
+}
+`
@@ -190,3 +190,171 @@ func TestDelete(t *testing.T) {
 	res := subnetpools.Delete(fake.ServiceClient(), "099546ca-788d-41e5-a76d-17d8cd282d3e")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestAddPrefixes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnetpools/099546ca-788d-41e5-a76d-17d8cd282d3e/add_prefixes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetPoolAddPrefixesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SubnetPoolAddPrefixesResponse)
+	})
+
+	opts := subnetpools.PrefixesOpts{
+		Prefixes: []string{"10.13.0.0/16"},
+	}
+	prefixes, err := subnetpools.AddPrefixes(fake.ServiceClient(), "099546ca-788d-41e5-a76d-17d8cd282d3e", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"10.8.0.0/16", "10.11.12.0/24", "10.13.0.0/16"}, prefixes)
+}
+
+func TestRemovePrefixes(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnetpools/099546ca-788d-41e5-a76d-17d8cd282d3e/remove_prefixes", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetPoolRemovePrefixesRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SubnetPoolRemovePrefixesResponse)
+	})
+
+	opts := subnetpools.PrefixesOpts{
+		Prefixes: []string{"10.11.12.0/24"},
+	}
+	prefixes, err := subnetpools.RemovePrefixes(fake.ServiceClient(), "099546ca-788d-41e5-a76d-17d8cd282d3e", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []string{"10.8.0.0/16", "10.13.0.0/16"}, prefixes)
+}
+
+func TestRequiredPrefixesOpts(t *testing.T) {
+	res := subnetpools.AddPrefixes(fake.ServiceClient(), "099546ca-788d-41e5-a76d-17d8cd282d3e", subnetpools.PrefixesOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestOnboardNetworkSubnets(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnetpools/099546ca-788d-41e5-a76d-17d8cd282d3e/onboard_network_subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetPoolOnboardNetworkSubnetsRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SubnetPoolOnboardNetworkSubnetsResponse)
+	})
+
+	opts := subnetpools.OnboardNetworkSubnetsOpts{
+		NetworkID: "8d4c70a8-a2d7-4ed9-b4f5-6f6a6a3bc8ea",
+	}
+	onboarded, err := subnetpools.OnboardNetworkSubnets(fake.ServiceClient(), "099546ca-788d-41e5-a76d-17d8cd282d3e", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, []subnetpools.OnboardedSubnet{
+		{ID: "a64d1c45-c22f-4f57-9e6b-41f6e3c25c8b", CIDR: "10.13.4.0/24"},
+	}, onboarded)
+}
+
+func TestNextFreePrefix(t *testing.T) {
+	pool := subnetpools.SubnetPool{
+		ID:           "2b4a4e3a-0b43-44b6-8a4d-b6b4a7c3a56e",
+		Prefixes:     []string{"10.1.0.0/24", "10.0.0.0/24"},
+		MinPrefixLen: 24,
+		MaxPrefixLen: 28,
+	}
+
+	// An empty pool returns the lowest prefix.
+	prefix, err := subnetpools.NextFreePrefix(pool, nil, 26)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "10.0.0.0/26", prefix)
+
+	// Used CIDRs are skipped, aligned on the requested length.
+	prefix, err = subnetpools.NextFreePrefix(pool, []string{"10.0.0.0/26", "10.0.0.64/27"}, 26)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "10.0.0.128/26", prefix)
+
+	// A full prefix moves on to the next one.
+	prefix, err = subnetpools.NextFreePrefix(pool, []string{"10.0.0.0/24"}, 24)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "10.1.0.0/24", prefix)
+
+	// CIDRs of another IP version are ignored.
+	prefix, err = subnetpools.NextFreePrefix(pool, []string{"fd00::/64"}, 28)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "10.0.0.0/28", prefix)
+
+	_, err = subnetpools.NextFreePrefix(pool, []string{"10.0.0.0/24", "10.1.0.0/25", "10.1.0.192/26"}, 25)
+	if _, ok := err.(subnetpools.ErrNoFreePrefix); !ok {
+		t.Fatalf("Expected ErrNoFreePrefix, got %v", err)
+	}
+
+	_, err = subnetpools.NextFreePrefix(pool, nil, 16)
+	if err == nil {
+		t.Fatalf("Expected error for a prefix length outside of the pool range, got none")
+	}
+}
+
+func TestNextFreePrefixIPv6(t *testing.T) {
+	pool := subnetpools.SubnetPool{
+		ID:       "4c0c6f0b-0f15-4b6e-a0b5-3dc3b59d5b47",
+		Prefixes: []string{"2001:db8::/48"},
+	}
+
+	prefix, err := subnetpools.NextFreePrefix(pool, []string{"2001:db8::/64", "2001:db8:0:1::/64", "2001:db8:0:4::/62"}, 64)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2001:db8:0:2::/64", prefix)
+
+	prefix, err = subnetpools.NextFreePrefix(pool, []string{"2001:db8::/64"}, 56)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "2001:db8:0:100::/56", prefix)
+}
+
+func TestFindNextFreePrefix(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnetpools/2b4a4e3a-0b43-44b6-8a4d-b6b4a7c3a56e", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SubnetPoolGetPrefixesResult)
+	})
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"subnetpool_id": "2b4a4e3a-0b43-44b6-8a4d-b6b4a7c3a56e"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SubnetsInPoolListResult)
+	})
+
+	prefix, err := subnetpools.FindNextFreePrefix(fake.ServiceClient(), "2b4a4e3a-0b43-44b6-8a4d-b6b4a7c3a56e", 26)
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "10.0.0.128/26", prefix)
+}
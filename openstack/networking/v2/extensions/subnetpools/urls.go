@@ -31,3 +31,15 @@ func updateURL(c *gophercloud.ServiceClient, id string) string {
 func deleteURL(c *gophercloud.ServiceClient, id string) string {
 	return resourceURL(c, id)
 }
+
+func addPrefixesURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "add_prefixes")
+}
+
+func removePrefixesURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "remove_prefixes")
+}
+
+func onboardNetworkSubnetsURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "onboard_network_subnets")
+}
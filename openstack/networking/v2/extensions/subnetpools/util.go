@@ -0,0 +1,144 @@
+package subnetpools
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/subnets"
+)
+
+// NextFreePrefix computes, on the client side, the lowest prefix of the
+// given length which lies within the prefixes of the subnetpool and does
+// not overlap any of the used CIDRs. CIDRs of another IP version than the
+// subnetpool are ignored.
+//
+// It is meant to preview an allocation: Neutron is free to pick another
+// prefix, and concurrent allocations may take the returned one first.
+func NextFreePrefix(pool SubnetPool, used []string, prefixLen int) (string, error) {
+	if (pool.MinPrefixLen > 0 && prefixLen < pool.MinPrefixLen) || (pool.MaxPrefixLen > 0 && prefixLen > pool.MaxPrefixLen) {
+		return "", fmt.Errorf("Prefix length %d is outside of the %d-%d range of subnetpool %s", prefixLen, pool.MinPrefixLen, pool.MaxPrefixLen, pool.ID)
+	}
+
+	usedNets := make([]*net.IPNet, 0, len(used))
+	for _, cidr := range used {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return "", err
+		}
+		usedNets = append(usedNets, n)
+	}
+
+	poolNets := make([]*net.IPNet, 0, len(pool.Prefixes))
+	for _, cidr := range pool.Prefixes {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return "", err
+		}
+		poolNets = append(poolNets, n)
+	}
+	sort.Slice(poolNets, func(i, j int) bool {
+		return ipToInt(poolNets[i].IP).Cmp(ipToInt(poolNets[j].IP)) < 0
+	})
+
+	for _, p := range poolNets {
+		ones, bits := p.Mask.Size()
+		if prefixLen < ones || prefixLen > bits {
+			continue
+		}
+
+		_, end := netRange(p)
+		size := new(big.Int).Lsh(big.NewInt(1), uint(bits-prefixLen))
+		candidate := ipToInt(p.IP)
+
+		for {
+			candidateEnd := new(big.Int).Add(candidate, size)
+			candidateEnd.Sub(candidateEnd, big.NewInt(1))
+			if candidateEnd.Cmp(end) > 0 {
+				break
+			}
+
+			// Find the end of the last used CIDR which overlaps the
+			// candidate, if any.
+			var overlapEnd *big.Int
+			for _, u := range usedNets {
+				if _, uBits := u.Mask.Size(); uBits != bits {
+					continue
+				}
+				uStart, uEnd := netRange(u)
+				if uStart.Cmp(candidateEnd) <= 0 && uEnd.Cmp(candidate) >= 0 {
+					if overlapEnd == nil || uEnd.Cmp(overlapEnd) > 0 {
+						overlapEnd = uEnd
+					}
+				}
+			}
+
+			if overlapEnd == nil {
+				return intToCIDR(candidate, prefixLen, bits), nil
+			}
+
+			// Skip past the overlapping CIDR, aligned on the prefix size.
+			candidate = new(big.Int).Add(overlapEnd, size)
+			candidate.Div(candidate, size)
+			candidate.Mul(candidate, size)
+		}
+	}
+
+	return "", ErrNoFreePrefix{SubnetPoolID: pool.ID, PrefixLen: prefixLen}
+}
+
+// FindNextFreePrefix retrieves a subnetpool and the subnets allocated from
+// it, and returns the next free prefix of the given length as computed by
+// NextFreePrefix.
+func FindNextFreePrefix(c *gophercloud.ServiceClient, subnetPoolID string, prefixLen int) (string, error) {
+	pool, err := Get(c, subnetPoolID).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	allPages, err := subnets.List(c, subnets.ListOpts{SubnetPoolID: subnetPoolID}).AllPages()
+	if err != nil {
+		return "", err
+	}
+
+	allSubnets, err := subnets.ExtractSubnets(allPages)
+	if err != nil {
+		return "", err
+	}
+
+	used := make([]string, 0, len(allSubnets))
+	for _, subnet := range allSubnets {
+		if subnet.SubnetPoolID == subnetPoolID {
+			used = append(used, subnet.CIDR)
+		}
+	}
+
+	return NextFreePrefix(*pool, used, prefixLen)
+}
+
+// netRange returns the first and the last addresses of a network as
+// integers.
+func netRange(n *net.IPNet) (*big.Int, *big.Int) {
+	ones, bits := n.Mask.Size()
+	start := ipToInt(n.IP)
+	end := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+	end.Add(end, start)
+	end.Sub(end, big.NewInt(1))
+	return start, end
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	return new(big.Int).SetBytes(ip)
+}
+
+func intToCIDR(i *big.Int, prefixLen, bits int) string {
+	b := i.Bytes()
+	ip := make(net.IP, bits/8)
+	copy(ip[len(ip)-len(b):], b)
+	return fmt.Sprintf("%s/%d", ip, prefixLen)
+}
@@ -0,0 +1,125 @@
+/*
+Package trunks provides the ability to retrieve and manage trunks through the
+Neutron API. A trunk is a parent port carrying the traffic of a number of
+sub-ports, each one told apart by its segmentation type and ID. This is also
+known as VLAN-aware VMs.
+
+Example of Creating a trunk
+
+	iTrue := true
+	createOpts := trunks.CreateOpts{
+		Name:         "gophertrunk",
+		AdminStateUp: &iTrue,
+		PortID:       "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+		Subports: []trunks.Subport{
+			{
+				SegmentationID:   1,
+				SegmentationType: trunks.SegmentationTypeVLAN,
+				PortID:           "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+			},
+		},
+	}
+
+	trunk, err := trunks.Create(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Listing trunks
+
+	listOpts := trunks.ListOpts{
+		Status: trunks.StatusActive,
+	}
+
+	allPages, err := trunks.List(networkClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTrunks, err := trunks.ExtractTrunks(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, trunk := range allTrunks {
+		fmt.Printf("%+v\n", trunk)
+	}
+
+Example of Updating a trunk
+
+	trunkID := "f6a9718c-5a64-43e3-944f-4deccad8e78c"
+	name := "new_name"
+	updateOpts := trunks.UpdateOpts{
+		Name: &name,
+	}
+
+	trunk, err := trunks.Update(networkClient, trunkID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Deleting a trunk
+
+	trunkID := "f6a9718c-5a64-43e3-944f-4deccad8e78c"
+	err := trunks.Delete(networkClient, trunkID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Getting the sub-ports of a trunk
+
+	trunkID := "f6a9718c-5a64-43e3-944f-4deccad8e78c"
+	subports, err := trunks.GetSubports(networkClient, trunkID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Adding sub-ports to a trunk and waiting for it to be ACTIVE
+
+	trunkID := "f6a9718c-5a64-43e3-944f-4deccad8e78c"
+	addSubportsOpts := trunks.AddSubportsOpts{
+		Subports: []trunks.Subport{
+			{
+				SegmentationID:   2,
+				SegmentationType: trunks.SegmentationTypeVLAN,
+				PortID:           "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab",
+			},
+		},
+	}
+
+	_, err := trunks.AddSubports(networkClient, trunkID, addSubportsOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	err = trunks.WaitForActive(networkClient, trunkID, 60)
+	if err != nil {
+		panic(err)
+	}
+
+Example of Removing sub-ports from a trunk
+
+	trunkID := "f6a9718c-5a64-43e3-944f-4deccad8e78c"
+	removeSubportsOpts := trunks.RemoveSubportsOpts{
+		Subports: []trunks.RemoveSubport{
+			{PortID: "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab"},
+		},
+	}
+
+	_, err := trunks.RemoveSubports(networkClient, trunkID, removeSubportsOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example of Getting the trunk details of a parent port
+
+	port, err := ports.Get(networkClient, "c373d2fa-3d3b-4492-924c-aff54dea19b6").Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	if port.TrunkDetails != nil {
+		fmt.Printf("Port is the parent of trunk %s\n", port.TrunkDetails.TrunkID)
+	}
+*/
+package trunks
@@ -0,0 +1,20 @@
+package trunks
+
+import (
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrTrunkInError is returned by WaitForActive when the trunk goes to ERROR
+// or DEGRADED while waiting for it to become ACTIVE. A DEGRADED trunk has
+// at least one sub-port which failed to be bound.
+type ErrTrunkInError struct {
+	gophercloud.BaseError
+	ID     string
+	Status string
+}
+
+func (e ErrTrunkInError) Error() string {
+	return fmt.Sprintf("Trunk %s is in %s status", e.ID, e.Status)
+}
@@ -0,0 +1,241 @@
+package trunks
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToTrunkListQuery() (string, error)
+}
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the trunk attributes you want to see returned. SortKey allows you to sort
+// by a particular trunk attribute. SortDir sets the direction, and is either
+// `asc' or `desc'. Marker and Limit are used for pagination.
+type ListOpts struct {
+	AdminStateUp   *bool  `q:"admin_state_up"`
+	Description    string `q:"description"`
+	ID             string `q:"id"`
+	Name           string `q:"name"`
+	PortID         string `q:"port_id"`
+	RevisionNumber *int   `q:"revision_number"`
+	Status         string `q:"status"`
+	TenantID       string `q:"tenant_id"`
+	ProjectID      string `q:"project_id"`
+	SortDir        string `q:"sort_dir"`
+	SortKey        string `q:"sort_key"`
+	Limit          int    `q:"limit"`
+	Marker         string `q:"marker"`
+}
+
+// ToTrunkListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTrunkListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// trunks. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(c)
+	if opts != nil {
+		query, err := opts.ToTrunkListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+	return pagination.NewPager(c, url, func(r pagination.PageResult) pagination.Page {
+		return TrunkPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves a specific trunk based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, id), &r.Body, nil)
+	return
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToTrunkCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents the attributes used when creating a new trunk.
+type CreateOpts struct {
+	// TenantID is the project owner of the trunk. Only administrative users
+	// can specify a project UUID other than their own.
+	TenantID string `json:"tenant_id,omitempty"`
+
+	// ProjectID is the project owner of the trunk.
+	ProjectID string `json:"project_id,omitempty"`
+
+	// PortID is the ID of the parent port of the trunk.
+	PortID string `json:"port_id" required:"true"`
+
+	// Name is a human-readable name of the trunk.
+	Name string `json:"name,omitempty"`
+
+	// Description is a human-readable description of the trunk.
+	Description string `json:"description,omitempty"`
+
+	// AdminStateUp is the administrative state of the trunk.
+	AdminStateUp *bool `json:"admin_state_up,omitempty"`
+
+	// Subports is a list of sub-ports to add to the trunk on creation.
+	Subports []Subport `json:"sub_ports"`
+}
+
+// ToTrunkCreateMap builds a request body from CreateOpts.
+func (opts CreateOpts) ToTrunkCreateMap() (map[string]interface{}, error) {
+	if opts.Subports == nil {
+		opts.Subports = []Subport{}
+	}
+	return gophercloud.BuildRequestBody(opts, "trunk")
+}
+
+// Create accepts a CreateOpts struct and creates a new trunk using the values
+// provided.
+func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToTrunkCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Post(createURL(c), b, &r.Body, nil)
+	return
+}
+
+// Delete accepts a unique ID and deletes the trunk associated with it.
+func Delete(c *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	_, r.Err = c.Delete(deleteURL(c, id), nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToTrunkUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents the attributes used when updating an existing trunk.
+type UpdateOpts struct {
+	Name         *string `json:"name,omitempty"`
+	AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+	Description  *string `json:"description,omitempty"`
+}
+
+// ToTrunkUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToTrunkUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "trunk")
+}
+
+// Update accepts an UpdateOpts struct and updates an existing trunk using the
+// values provided.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToTrunkUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updateURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// GetSubports retrieves the sub-ports of the trunk associated with the given
+// ID.
+func GetSubports(c *gophercloud.ServiceClient, id string) (r GetSubportsResult) {
+	_, r.Err = c.Get(getSubportsURL(c, id), &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// AddSubportsOptsBuilder allows extensions to add additional parameters to
+// the AddSubports request.
+type AddSubportsOptsBuilder interface {
+	ToTrunkAddSubportsMap() (map[string]interface{}, error)
+}
+
+// AddSubportsOpts represents the sub-ports to add to a trunk.
+type AddSubportsOpts struct {
+	Subports []Subport `json:"sub_ports" required:"true"`
+}
+
+// ToTrunkAddSubportsMap builds a request body from AddSubportsOpts. Every
+// sub-port must carry a PortID.
+func (opts AddSubportsOpts) ToTrunkAddSubportsMap() (map[string]interface{}, error) {
+	for _, s := range opts.Subports {
+		if s.PortID == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "trunks.Subport.PortID"
+			return nil, err
+		}
+	}
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// AddSubports adds the given sub-ports to the trunk associated with the given
+// ID. The trunk may be in BUILD or DOWN state for a while afterwards; see
+// WaitForActive.
+func AddSubports(c *gophercloud.ServiceClient, id string, opts AddSubportsOptsBuilder) (r UpdateSubportsResult) {
+	b, err := opts.ToTrunkAddSubportsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(addSubportsURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// RemoveSubportsOptsBuilder allows extensions to add additional parameters
+// to the RemoveSubports request.
+type RemoveSubportsOptsBuilder interface {
+	ToTrunkRemoveSubportsMap() (map[string]interface{}, error)
+}
+
+// RemoveSubport identifies a sub-port to remove from a trunk.
+type RemoveSubport struct {
+	PortID string `json:"port_id" required:"true"`
+}
+
+// RemoveSubportsOpts represents the sub-ports to remove from a trunk.
+type RemoveSubportsOpts struct {
+	Subports []RemoveSubport `json:"sub_ports" required:"true"`
+}
+
+// ToTrunkRemoveSubportsMap builds a request body from RemoveSubportsOpts.
+func (opts RemoveSubportsOpts) ToTrunkRemoveSubportsMap() (map[string]interface{}, error) {
+	for _, s := range opts.Subports {
+		if s.PortID == "" {
+			err := gophercloud.ErrMissingInput{}
+			err.Argument = "trunks.RemoveSubport.PortID"
+			return nil, err
+		}
+	}
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// RemoveSubports removes the given sub-ports from the trunk associated with
+// the given ID.
+func RemoveSubports(c *gophercloud.ServiceClient, id string, opts RemoveSubportsOptsBuilder) (r UpdateSubportsResult) {
+	b, err := opts.ToTrunkRemoveSubportsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(removeSubportsURL(c, id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
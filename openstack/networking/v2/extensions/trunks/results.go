@@ -0,0 +1,181 @@
+package trunks
+
+import (
+	"time"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// Trunk statuses as reported by the Networking service.
+const (
+	StatusActive   = "ACTIVE"
+	StatusDown     = "DOWN"
+	StatusBuild    = "BUILD"
+	StatusDegraded = "DEGRADED"
+	StatusError    = "ERROR"
+)
+
+// Segmentation types supported for sub-ports.
+const (
+	SegmentationTypeVLAN    = "vlan"
+	SegmentationTypeInherit = "inherit"
+)
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a Trunk.
+func (r commonResult) Extract() (*Trunk, error) {
+	var s struct {
+		Trunk *Trunk `json:"trunk"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Trunk, err
+}
+
+// CreateResult represents the result of a create operation. Call its Extract
+// method to interpret it as a Trunk.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation. Call its Extract
+// method to interpret it as a Trunk.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation. Call its Extract
+// method to interpret it as a Trunk.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation. Call its
+// ExtractErr method to determine if the request succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateSubportsResult represents the result of an AddSubports or
+// RemoveSubports operation. Call its Extract method to interpret it as a
+// Trunk.
+type UpdateSubportsResult struct {
+	commonResult
+}
+
+// GetSubportsResult represents the result of a GetSubports operation. Call
+// its Extract method to interpret it as a slice of Subport.
+type GetSubportsResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the sub-ports of
+// a trunk.
+func (r GetSubportsResult) Extract() ([]Subport, error) {
+	var s struct {
+		Subports []Subport `json:"sub_ports"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Subports, err
+}
+
+// Subport represents a port attached to a trunk, along with the segmentation
+// details used to tell its traffic apart on the parent port.
+type Subport struct {
+	// SegmentationID is the segmentation ID, e.g. the VLAN ID, of the
+	// sub-port. It may be omitted when SegmentationType is "inherit".
+	SegmentationID int `json:"segmentation_id,omitempty"`
+
+	// SegmentationType is the segmentation type of the sub-port, e.g. "vlan"
+	// or "inherit".
+	SegmentationType string `json:"segmentation_type,omitempty"`
+
+	// PortID is the ID of the sub-port.
+	PortID string `json:"port_id"`
+}
+
+// Trunk represents a Neutron trunk, a parent port carrying the traffic of a
+// number of sub-ports.
+type Trunk struct {
+	// AdminStateUp is the administrative state of the trunk.
+	AdminStateUp bool `json:"admin_state_up"`
+
+	// Description is a human-readable description of the trunk.
+	Description string `json:"description"`
+
+	// ID is the unique ID of the trunk.
+	ID string `json:"id"`
+
+	// Name is a human-readable name of the trunk.
+	Name string `json:"name"`
+
+	// PortID is the ID of the parent port of the trunk.
+	PortID string `json:"port_id"`
+
+	// RevisionNumber is the revision number of the trunk.
+	RevisionNumber int `json:"revision_number"`
+
+	// Status is the status of the trunk, e.g. ACTIVE, DOWN, BUILD, DEGRADED
+	// or ERROR.
+	Status string `json:"status"`
+
+	// TenantID is the project owner of the trunk.
+	TenantID string `json:"tenant_id"`
+
+	// ProjectID is the project owner of the trunk.
+	ProjectID string `json:"project_id"`
+
+	// CreatedAt is the time at which the trunk has been created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time at which the trunk has been updated.
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// Subports is the list of sub-ports attached to the trunk.
+	Subports []Subport `json:"sub_ports"`
+
+	// Tags is the list of tags on the trunk.
+	Tags []string `json:"tags"`
+}
+
+// TrunkPage is the page returned by a pager when traversing over a
+// collection of trunks.
+type TrunkPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of trunks has reached
+// the end of a page and the pager seeks to traverse over a new one. In order
+// to do this, it needs to construct the next page's URL.
+func (r TrunkPage) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"trunks_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+// IsEmpty checks whether a TrunkPage struct is empty.
+func (r TrunkPage) IsEmpty() (bool, error) {
+	is, err := ExtractTrunks(r)
+	return len(is) == 0, err
+}
+
+// ExtractTrunks accepts a Page struct, specifically a TrunkPage struct,
+// and extracts the elements into a slice of Trunk structs.
+func ExtractTrunks(r pagination.Page) ([]Trunk, error) {
+	var s []Trunk
+	err := ExtractTrunksInto(r, &s)
+	return s, err
+}
+
+// ExtractTrunksInto extracts the elements into a slice of Trunk structs.
+func ExtractTrunksInto(r pagination.Page, v interface{}) error {
+	return r.(TrunkPage).Result.ExtractIntoSlicePtr(v, "trunks")
+}
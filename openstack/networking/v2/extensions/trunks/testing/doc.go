@@ -0,0 +1,2 @@
+// trunks unit tests
+package testing
@@ -0,0 +1,219 @@
+package testing
+
+import (
+	"time"
+
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/trunks"
+)
+
+const CreateRequest = `
+{
+    "trunk": {
+        "admin_state_up": true,
+        "description": "Trunk created by gophercloud",
+        "name": "gophertrunk",
+        "port_id": "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+        "sub_ports": [
+            {
+                "port_id": "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+                "segmentation_id": 1,
+                "segmentation_type": "vlan"
+            },
+            {
+                "port_id": "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab",
+                "segmentation_id": 2,
+                "segmentation_type": "vlan"
+            }
+        ]
+    }
+}`
+
+const CreateNoSubportsRequest = `
+{
+    "trunk": {
+        "port_id": "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+        "sub_ports": []
+    }
+}`
+
+const TrunkResponse = `
+{
+    "trunk": {
+        "admin_state_up": true,
+        "created_at": "2018-10-03T13:57:24Z",
+        "description": "Trunk created by gophercloud",
+        "id": "f6a9718c-5a64-43e3-944f-4deccad8e78c",
+        "name": "gophertrunk",
+        "port_id": "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+        "project_id": "e153f3f9082240a5974f667cfe1036e3",
+        "revision_number": 1,
+        "status": "ACTIVE",
+        "sub_ports": [
+            {
+                "port_id": "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+                "segmentation_id": 1,
+                "segmentation_type": "vlan"
+            },
+            {
+                "port_id": "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab",
+                "segmentation_id": 2,
+                "segmentation_type": "vlan"
+            }
+        ],
+        "tags": [],
+        "tenant_id": "e153f3f9082240a5974f667cfe1036e3",
+        "updated_at": "2018-10-03T13:57:26Z"
+    }
+}`
+
+const ListResponse = `
+{
+    "trunks": [
+        {
+            "admin_state_up": true,
+            "created_at": "2018-10-03T13:57:24Z",
+            "description": "Trunk created by gophercloud",
+            "id": "f6a9718c-5a64-43e3-944f-4deccad8e78c",
+            "name": "gophertrunk",
+            "port_id": "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+            "project_id": "e153f3f9082240a5974f667cfe1036e3",
+            "revision_number": 1,
+            "status": "ACTIVE",
+            "sub_ports": [
+                {
+                    "port_id": "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+                    "segmentation_id": 1,
+                    "segmentation_type": "vlan"
+                },
+                {
+                    "port_id": "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab",
+                    "segmentation_id": 2,
+                    "segmentation_type": "vlan"
+                }
+            ],
+            "tags": [],
+            "tenant_id": "e153f3f9082240a5974f667cfe1036e3",
+            "updated_at": "2018-10-03T13:57:26Z"
+        }
+    ]
+}`
+
+const UpdateRequest = `
+{
+    "trunk": {
+        "admin_state_up": false,
+        "description": "",
+        "name": "updated_gophertrunk"
+    }
+}`
+
+const UpdateResponse = `
+{
+    "trunk": {
+        "admin_state_up": false,
+        "created_at": "2018-10-03T13:57:24Z",
+        "description": "",
+        "id": "f6a9718c-5a64-43e3-944f-4deccad8e78c",
+        "name": "updated_gophertrunk",
+        "port_id": "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+        "project_id": "e153f3f9082240a5974f667cfe1036e3",
+        "revision_number": 6,
+        "status": "ACTIVE",
+        "sub_ports": [],
+        "tags": [],
+        "tenant_id": "e153f3f9082240a5974f667cfe1036e3",
+        "updated_at": "2018-10-03T13:57:33Z"
+    }
+}`
+
+const GetSubportsResponse = `
+{
+    "sub_ports": [
+        {
+            "port_id": "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+            "segmentation_id": 1,
+            "segmentation_type": "vlan"
+        },
+        {
+            "port_id": "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab",
+            "segmentation_id": 2,
+            "segmentation_type": "vlan"
+        }
+    ]
+}`
+
+const AddSubportsRequest = `
+{
+    "sub_ports": [
+        {
+            "port_id": "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+            "segmentation_id": 1,
+            "segmentation_type": "vlan"
+        },
+        {
+            "port_id": "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab",
+            "segmentation_id": 2,
+            "segmentation_type": "vlan"
+        }
+    ]
+}`
+
+const RemoveSubportsRequest = `
+{
+    "sub_ports": [
+        {
+            "port_id": "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b"
+        },
+        {
+            "port_id": "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab"
+        }
+    ]
+}`
+
+const RemoveSubportsResponse = `
+{
+    "trunk": {
+        "admin_state_up": true,
+        "created_at": "2018-10-03T13:57:24Z",
+        "description": "Trunk created by gophercloud",
+        "id": "f6a9718c-5a64-43e3-944f-4deccad8e78c",
+        "name": "gophertrunk",
+        "port_id": "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+        "project_id": "e153f3f9082240a5974f667cfe1036e3",
+        "revision_number": 3,
+        "status": "DOWN",
+        "sub_ports": [],
+        "tags": [],
+        "tenant_id": "e153f3f9082240a5974f667cfe1036e3",
+        "updated_at": "2018-10-03T13:57:30Z"
+    }
+}`
+
+var ExpectedSubports = []trunks.Subport{
+	{
+		PortID:           "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+		SegmentationID:   1,
+		SegmentationType: "vlan",
+	},
+	{
+		PortID:           "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab",
+		SegmentationID:   2,
+		SegmentationType: "vlan",
+	},
+}
+
+var ExpectedTrunk = trunks.Trunk{
+	AdminStateUp:   true,
+	Description:    "Trunk created by gophercloud",
+	ID:             "f6a9718c-5a64-43e3-944f-4deccad8e78c",
+	Name:           "gophertrunk",
+	PortID:         "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+	ProjectID:      "e153f3f9082240a5974f667cfe1036e3",
+	TenantID:       "e153f3f9082240a5974f667cfe1036e3",
+	RevisionNumber: 1,
+	Status:         "ACTIVE",
+	Subports:       ExpectedSubports,
+	Tags:           []string{},
+	CreatedAt:      time.Date(2018, 10, 3, 13, 57, 24, 0, time.UTC),
+	UpdatedAt:      time.Date(2018, 10, 3, 13, 57, 26, 0, time.UTC),
+}
@@ -0,0 +1,307 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/trunks"
+	"github.com/chjlangzi/gophercloud/pagination"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, TrunkResponse)
+	})
+
+	iTrue := true
+	options := trunks.CreateOpts{
+		Name:         "gophertrunk",
+		Description:  "Trunk created by gophercloud",
+		AdminStateUp: &iTrue,
+		PortID:       "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+		Subports: []trunks.Subport{
+			{
+				SegmentationID:   1,
+				SegmentationType: trunks.SegmentationTypeVLAN,
+				PortID:           "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+			},
+			{
+				SegmentationID:   2,
+				SegmentationType: trunks.SegmentationTypeVLAN,
+				PortID:           "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab",
+			},
+		},
+	}
+	trunk, err := trunks.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedTrunk, trunk)
+}
+
+func TestCreateNoSubports(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, CreateNoSubportsRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, TrunkResponse)
+	})
+
+	options := trunks.CreateOpts{
+		PortID: "c373d2fa-3d3b-4492-924c-aff54dea19b6",
+	}
+	_, err := trunks.Create(fake.ServiceClient(), options).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestRequiredCreateOpts(t *testing.T) {
+	res := trunks.Create(fake.ServiceClient(), trunks.CreateOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks/f6a9718c-5a64-43e3-944f-4deccad8e78c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := trunks.Delete(fake.ServiceClient(), "f6a9718c-5a64-43e3-944f-4deccad8e78c")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{"status": "ACTIVE"})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	client := fake.ServiceClient()
+	count := 0
+
+	trunks.List(client, trunks.ListOpts{Status: trunks.StatusActive}).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+		actual, err := trunks.ExtractTrunks(page)
+		if err != nil {
+			t.Errorf("Failed to extract trunks: %v", err)
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, []trunks.Trunk{ExpectedTrunk}, actual)
+
+		return true, nil
+	})
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks/f6a9718c-5a64-43e3-944f-4deccad8e78c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, TrunkResponse)
+	})
+
+	n, err := trunks.Get(fake.ServiceClient(), "f6a9718c-5a64-43e3-944f-4deccad8e78c").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedTrunk, n)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks/f6a9718c-5a64-43e3-944f-4deccad8e78c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateResponse)
+	})
+
+	iFalse := false
+	name := "updated_gophertrunk"
+	description := ""
+	options := trunks.UpdateOpts{
+		Name:         &name,
+		AdminStateUp: &iFalse,
+		Description:  &description,
+	}
+	n, err := trunks.Update(fake.ServiceClient(), "f6a9718c-5a64-43e3-944f-4deccad8e78c", options).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, n.Name, name)
+	th.AssertEquals(t, n.AdminStateUp, iFalse)
+	th.AssertEquals(t, n.Description, description)
+}
+
+func TestGetSubports(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks/f6a9718c-5a64-43e3-944f-4deccad8e78c/get_subports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetSubportsResponse)
+	})
+
+	subports, err := trunks.GetSubports(fake.ServiceClient(), "f6a9718c-5a64-43e3-944f-4deccad8e78c").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedSubports, subports)
+}
+
+func TestAddSubports(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks/f6a9718c-5a64-43e3-944f-4deccad8e78c/add_subports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, AddSubportsRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, TrunkResponse)
+	})
+
+	opts := trunks.AddSubportsOpts{
+		Subports: ExpectedSubports,
+	}
+	trunk, err := trunks.AddSubports(fake.ServiceClient(), "f6a9718c-5a64-43e3-944f-4deccad8e78c", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedTrunk, trunk)
+}
+
+func TestAddSubportsMissingPortID(t *testing.T) {
+	opts := trunks.AddSubportsOpts{
+		Subports: []trunks.Subport{
+			{SegmentationID: 1, SegmentationType: trunks.SegmentationTypeVLAN},
+		},
+	}
+	res := trunks.AddSubports(fake.ServiceClient(), "f6a9718c-5a64-43e3-944f-4deccad8e78c", opts)
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
+
+func TestRemoveSubports(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks/f6a9718c-5a64-43e3-944f-4deccad8e78c/remove_subports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, RemoveSubportsRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, RemoveSubportsResponse)
+	})
+
+	opts := trunks.RemoveSubportsOpts{
+		Subports: []trunks.RemoveSubport{
+			{PortID: "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b"},
+			{PortID: "4c8b2bff-9824-4d4c-9b60-b3f6621b2bab"},
+		},
+	}
+	trunk, err := trunks.RemoveSubports(fake.ServiceClient(), "f6a9718c-5a64-43e3-944f-4deccad8e78c", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, trunks.StatusDown, trunk.Status)
+	th.AssertEquals(t, 0, len(trunk.Subports))
+}
+
+func TestWaitForActive(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks/f6a9718c-5a64-43e3-944f-4deccad8e78c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, TrunkResponse)
+	})
+
+	err := trunks.WaitForActive(fake.ServiceClient(), "f6a9718c-5a64-43e3-944f-4deccad8e78c", 5)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForActiveDegraded(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/trunks/f6a9718c-5a64-43e3-944f-4deccad8e78c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `{"trunk": {"id": "f6a9718c-5a64-43e3-944f-4deccad8e78c", "status": "DEGRADED"}}`)
+	})
+
+	err := trunks.WaitForActive(fake.ServiceClient(), "f6a9718c-5a64-43e3-944f-4deccad8e78c", 5)
+	if _, ok := err.(trunks.ErrTrunkInError); !ok {
+		t.Fatalf("Expected ErrTrunkInError, got %v", err)
+	}
+}
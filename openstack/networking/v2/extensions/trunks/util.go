@@ -0,0 +1,44 @@
+package trunks
+
+import "github.com/chjlangzi/gophercloud"
+
+// WaitForStatus will continually poll a trunk until it successfully
+// transitions to a specified status. It will do this for at most the number
+// of seconds specified.
+func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
+	return gophercloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		return false, nil
+	})
+}
+
+// WaitForActive will continually poll a trunk until it becomes ACTIVE, which
+// is what a trunk goes back to once the sub-port changes made by AddSubports
+// or RemoveSubports have been wired. It returns an ErrTrunkInError as soon as
+// the trunk goes to ERROR or DEGRADED. It will do this for at most the number
+// of seconds specified.
+func WaitForActive(c *gophercloud.ServiceClient, id string, secs int) error {
+	return gophercloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		switch current.Status {
+		case StatusActive:
+			return true, nil
+		case StatusError, StatusDegraded:
+			return false, ErrTrunkInError{ID: id, Status: current.Status}
+		}
+
+		return false, nil
+	})
+}
@@ -41,6 +41,19 @@ Example to Create a Network
 		panic(err)
 	}
 
+Example to Create Several Networks in a Single Request
+
+	iTrue := true
+	createOpts := []networks.CreateOptsBuilder{
+		networks.CreateOpts{Name: "network_1", AdminStateUp: &iTrue},
+		networks.CreateOpts{Name: "network_2", AdminStateUp: &iTrue},
+	}
+
+	allNetworks, err := networks.BulkCreate(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Network
 
 	networkID := "484cda0e-106f-4f4b-bb3f-d413710bbe78"
@@ -2,6 +2,7 @@ package networks
 
 import (
 	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/internal"
 	"github.com/chjlangzi/gophercloud/pagination"
 )
 
@@ -28,6 +29,10 @@ type ListOpts struct {
 	Limit        int    `q:"limit"`
 	SortKey      string `q:"sort_key"`
 	SortDir      string `q:"sort_dir"`
+	Tags         string `q:"tags"`
+	TagsAny      string `q:"tags-any"`
+	NotTags      string `q:"not-tags"`
+	NotTagsAny   string `q:"not-tags-any"`
 	RouterExternal bool `q:"router:external"`
 }
 
@@ -98,6 +103,24 @@ func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResul
 	return
 }
 
+// BulkCreate creates all the networks described by opts in a single request.
+// Either all of them are created or, if an error is returned, none of them.
+// A bulk.ErrNotSupported is returned if the Networking service has bulk
+// operations disabled.
+func BulkCreate(c *gophercloud.ServiceClient, opts []CreateOptsBuilder) (r BulkCreateResult) {
+	bodies := make([]map[string]interface{}, len(opts))
+	for i, opt := range opts {
+		b, err := opt.ToNetworkCreateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		bodies[i] = b
+	}
+	r.Err = internal.BulkCreate(c, createURL(c), "network", "networks", bodies, &r.Body)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -38,6 +38,24 @@ type UpdateResult struct {
 	commonResult
 }
 
+// BulkCreateResult represents the result of a bulk create operation. Call its
+// Extract method to interpret it as a slice of Networks.
+type BulkCreateResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the networks
+// created by BulkCreate, in the order of the request.
+func (r BulkCreateResult) Extract() ([]Network, error) {
+	var s []Network
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func (r BulkCreateResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoSlicePtr(v, "networks")
+}
+
 // DeleteResult represents the result of a delete operation. Call its
 // ExtractErr method to determine if the request succeeded or failed.
 type DeleteResult struct {
@@ -76,6 +94,9 @@ type Network struct {
 	// Availability zone hints groups network nodes that run services like DHCP, L3, FW, and others.
 	// Used to make network resources highly available.
 	AvailabilityZoneHints []string `json:"availability_zone_hints"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
 }
 
 // NetworkPage is the page returned by a pager when traversing over a
@@ -193,3 +193,58 @@ var Network2 = networks.Network{
 }
 
 var ExpectedNetworkSlice = []networks.Network{Network1, Network2}
+
+const ListWithTagsResponse = `
+{
+    "networks": [
+        {
+            "status": "ACTIVE",
+            "subnets": [],
+            "name": "tagged-network",
+            "admin_state_up": true,
+            "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+            "shared": false,
+            "id": "39a7dd2b-7ed1-4aab-a6b8-0c9e5d65b4b1",
+            "tags": ["ipam", "prod"]
+        }
+    ]
+}
+`
+
+const BulkCreateRequest = `
+{
+    "networks": [
+        {
+            "name": "sample_network_1",
+            "admin_state_up": true
+        },
+        {
+            "name": "sample_network_2",
+            "admin_state_up": false
+        }
+    ]
+}`
+
+const BulkCreateResponse = `
+{
+    "networks": [
+        {
+            "status": "ACTIVE",
+            "subnets": [],
+            "name": "sample_network_1",
+            "admin_state_up": true,
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "shared": false,
+            "id": "de2ef6d9-3f92-4c9d-9ec4-1c5a0e7a1f41"
+        },
+        {
+            "status": "ACTIVE",
+            "subnets": [],
+            "name": "sample_network_2",
+            "admin_state_up": false,
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "shared": false,
+            "id": "9d1c3d4e-0b36-4e7f-8f5c-6f1d9b0ab3c2"
+        }
+    ]
+}`
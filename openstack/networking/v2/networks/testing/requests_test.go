@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/bulk"
 	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
 	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/portsecurity"
 	"github.com/chjlangzi/gophercloud/openstack/networking/v2/networks"
@@ -47,6 +49,43 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListWithTags(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"tags":         "ipam,prod",
+			"tags-any":     "a,b",
+			"not-tags":     "stale",
+			"not-tags-any": "c,d",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListWithTagsResponse)
+	})
+
+	listOpts := networks.ListOpts{
+		Tags:       "ipam,prod",
+		TagsAny:    "a,b",
+		NotTags:    "stale",
+		NotTagsAny: "c,d",
+	}
+
+	allPages, err := networks.List(fake.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := networks.ExtractNetworks(allPages)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(actual))
+	th.AssertDeepEquals(t, []string{"ipam", "prod"}, actual[0].Tags)
+}
+
 func TestListWithExtensions(t *testing.T) {
 	th.SetupHTTP()
 	defer th.TeardownHTTP()
@@ -293,3 +332,115 @@ func TestUpdatePortSecurity(t *testing.T) {
 	th.AssertEquals(t, networkWithExtensions.ID, "4e8e5957-649f-477b-9e5b-f1f75b21c03c")
 	th.AssertEquals(t, networkWithExtensions.PortSecurityEnabled, false)
 }
+
+func TestBulkCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, BulkCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, BulkCreateResponse)
+	})
+
+	iTrue := true
+	iFalse := false
+	opts := []networks.CreateOptsBuilder{
+		networks.CreateOpts{Name: "sample_network_1", AdminStateUp: &iTrue},
+		networks.CreateOpts{Name: "sample_network_2", AdminStateUp: &iFalse},
+	}
+
+	n, err := networks.BulkCreate(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(n))
+	th.AssertEquals(t, "de2ef6d9-3f92-4c9d-9ec4-1c5a0e7a1f41", n[0].ID)
+	th.AssertEquals(t, "sample_network_1", n[0].Name)
+	th.AssertEquals(t, "9d1c3d4e-0b36-4e7f-8f5c-6f1d9b0ab3c2", n[1].ID)
+	th.AssertEquals(t, false, n[1].AdminStateUp)
+}
+
+func TestBulkCreateWithExtensions(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestJSONRequest(t, r, `
+{
+    "networks": [
+        {
+            "name": "sample_network_1",
+            "port_security_enabled": false
+        }
+    ]
+}`)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, `{"networks": [{"id": "de2ef6d9-3f92-4c9d-9ec4-1c5a0e7a1f41", "port_security_enabled": false}]}`)
+	})
+
+	var networksWithExtensions []struct {
+		networks.Network
+		portsecurity.PortSecurityExt
+	}
+
+	iFalse := false
+	opts := []networks.CreateOptsBuilder{
+		portsecurity.NetworkCreateOptsExt{
+			CreateOptsBuilder:   networks.CreateOpts{Name: "sample_network_1"},
+			PortSecurityEnabled: &iFalse,
+		},
+	}
+
+	err := networks.BulkCreate(fake.ServiceClient(), opts).ExtractInto(&networksWithExtensions)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 1, len(networksWithExtensions))
+	th.AssertEquals(t, false, networksWithExtensions[0].PortSecurityEnabled)
+}
+
+func TestBulkCreateNotSupported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/networks", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		fmt.Fprintf(w, `{"NeutronError": {"type": "HTTPBadRequest", "message": "Bulk operation not supported", "detail": ""}}`)
+	})
+
+	opts := []networks.CreateOptsBuilder{
+		networks.CreateOpts{Name: "sample_network_1"},
+		networks.CreateOpts{Name: "sample_network_2"},
+	}
+
+	_, err := networks.BulkCreate(fake.ServiceClient(), opts).Extract()
+	e, ok := err.(bulk.ErrNotSupported)
+	if !ok {
+		t.Fatalf("Expected bulk.ErrNotSupported, got %v", err)
+	}
+	th.AssertEquals(t, "networks", e.Resource)
+	if _, ok := e.Err.(gophercloud.ErrDefault400); !ok {
+		t.Fatalf("Expected the original 400 error to be kept, got %v", e.Err)
+	}
+}
+
+func TestBulkCreateRequiredOpts(t *testing.T) {
+	res := networks.BulkCreate(fake.ServiceClient(), nil)
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/internal"
 	"github.com/chjlangzi/gophercloud/pagination"
 )
 
@@ -31,6 +32,10 @@ type ListOpts struct {
 	Marker       string `q:"marker"`
 	SortKey      string `q:"sort_key"`
 	SortDir      string `q:"sort_dir"`
+	Tags         string `q:"tags"`
+	TagsAny      string `q:"tags-any"`
+	NotTags      string `q:"not-tags"`
+	NotTagsAny   string `q:"not-tags-any"`
 }
 
 // ToPortListQuery formats a ListOpts into a query string.
@@ -104,6 +109,24 @@ func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResul
 	return
 }
 
+// BulkCreate creates all the ports described by opts in a single request.
+// Either all of them are created or, if an error is returned, none of them.
+// A bulk.ErrNotSupported is returned if the Networking service has bulk
+// operations disabled.
+func BulkCreate(c *gophercloud.ServiceClient, opts []CreateOptsBuilder) (r BulkCreateResult) {
+	bodies := make([]map[string]interface{}, len(opts))
+	for i, opt := range opts {
+		b, err := opt.ToPortCreateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		bodies[i] = b
+	}
+	r.Err = internal.BulkCreate(c, createURL(c), "port", "ports", bodies, &r.Body)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
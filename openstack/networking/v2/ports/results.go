@@ -38,6 +38,24 @@ type UpdateResult struct {
 	commonResult
 }
 
+// BulkCreateResult represents the result of a bulk create operation. Call its
+// Extract method to interpret it as a slice of Ports.
+type BulkCreateResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the ports
+// created by BulkCreate, in the order of the request.
+func (r BulkCreateResult) Extract() ([]Port, error) {
+	var s []Port
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func (r BulkCreateResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoSlicePtr(v, "ports")
+}
+
 // DeleteResult represents the result of a delete operation. Call its
 // ExtractErr method to determine if the request succeeded or failed.
 type DeleteResult struct {
@@ -101,6 +119,31 @@ type Port struct {
 
 	// Identifies the list of IP addresses the port will recognize/accept
 	AllowedAddressPairs []AddressPair `json:"allowed_address_pairs"`
+
+	// TrunkDetails is set when the port is the parent port of a trunk. It is
+	// only reported when the trunk-details extension is enabled.
+	TrunkDetails *TrunkDetails `json:"trunk_details,omitempty"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
+}
+
+// TrunkDetails describes the trunk a parent port belongs to.
+type TrunkDetails struct {
+	// TrunkID is the ID of the trunk.
+	TrunkID string `json:"trunk_id"`
+
+	// SubPorts is the list of sub-ports attached to the trunk.
+	SubPorts []TrunkSubport `json:"sub_ports"`
+}
+
+// TrunkSubport is a sub-port as reported in the trunk details of a parent
+// port.
+type TrunkSubport struct {
+	SegmentationID   int    `json:"segmentation_id"`
+	SegmentationType string `json:"segmentation_type"`
+	PortID           string `json:"port_id"`
+	MACAddress       string `json:"mac_address"`
 }
 
 // PortPage is the page returned by a pager when traversing over a collection
@@ -708,3 +708,96 @@ const UpdateWithExtraDHCPOptsResponse = `
     }
 }
 `
+
+const GetWithTrunkDetailsResponse = `
+{
+    "port": {
+        "status": "ACTIVE",
+        "name": "trunk-parent",
+        "admin_state_up": true,
+        "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+        "tenant_id": "7e02058126cc4950b75f9970368ba177",
+        "device_owner": "compute:nova",
+        "mac_address": "fa:16:3e:23:fd:d7",
+        "fixed_ips": [],
+        "id": "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2",
+        "security_groups": [],
+        "device_id": "5e3898d7-11be-483e-9732-b2f5eccd2b2e",
+        "trunk_details": {
+            "trunk_id": "f6a9718c-5a64-43e3-944f-4deccad8e78c",
+            "sub_ports": [
+                {
+                    "segmentation_id": 100,
+                    "segmentation_type": "vlan",
+                    "port_id": "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+                    "mac_address": "fa:16:3e:1f:de:6d"
+                }
+            ]
+        }
+    }
+}
+`
+
+const BulkCreateRequest = `
+{
+    "ports": [
+        {
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "name": "private-port-1",
+            "admin_state_up": true
+        },
+        {
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "name": "private-port-2",
+            "admin_state_up": true
+        }
+    ]
+}
+`
+
+const BulkCreateResponse = `
+{
+    "ports": [
+        {
+            "status": "DOWN",
+            "name": "private-port-1",
+            "admin_state_up": true,
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+            "device_owner": "",
+            "mac_address": "fa:16:3e:c9:cb:f0",
+            "fixed_ips": [
+                {
+                    "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "10.0.0.2"
+                }
+            ],
+            "id": "65c0ee9f-d634-4522-8954-51021b570b0d",
+            "security_groups": [
+                "f0ac4394-7e4a-4409-9701-ba8be283dbc3"
+            ],
+            "device_id": ""
+        },
+        {
+            "status": "DOWN",
+            "name": "private-port-2",
+            "admin_state_up": true,
+            "network_id": "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+            "tenant_id": "d6700c0c9ffa4f1cb322cd4a1f3906fa",
+            "device_owner": "",
+            "mac_address": "fa:16:3e:c9:cb:f1",
+            "fixed_ips": [
+                {
+                    "subnet_id": "a0304c3a-4f08-4c43-88af-d796509c97d2",
+                    "ip_address": "10.0.0.3"
+                }
+            ],
+            "id": "b1a3c5d7-2f4e-4a6b-8c9d-0e1f2a3b4c5d",
+            "security_groups": [
+                "f0ac4394-7e4a-4409-9701-ba8be283dbc3"
+            ],
+            "device_id": ""
+        }
+    ]
+}
+`
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/bulk"
 	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
 	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/extradhcpopts"
 	"github.com/chjlangzi/gophercloud/openstack/networking/v2/extensions/portsecurity"
@@ -777,3 +779,130 @@ func TestUpdateWithExtraDHCPOpts(t *testing.T) {
 	th.AssertDeepEquals(t, s.ExtraDHCPOpts[0].OptValue, "value2")
 	th.AssertDeepEquals(t, s.ExtraDHCPOpts[0].IPVersion, 4)
 }
+
+func TestGetWithTrunkDetails(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports/46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetWithTrunkDetailsResponse)
+	})
+
+	n, err := ports.Get(fake.ServiceClient(), "46d4bfb9-b26e-41f3-bd2e-e6dcc1ccedb2").Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertDeepEquals(t, n.TrunkDetails, &ports.TrunkDetails{
+		TrunkID: "f6a9718c-5a64-43e3-944f-4deccad8e78c",
+		SubPorts: []ports.TrunkSubport{
+			{
+				SegmentationID:   100,
+				SegmentationType: "vlan",
+				PortID:           "28e452d7-4f8a-4be4-b1e6-7f3db4c0430b",
+				MACAddress:       "fa:16:3e:1f:de:6d",
+			},
+		},
+	})
+}
+
+func TestBulkCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, BulkCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, BulkCreateResponse)
+	})
+
+	asu := true
+	opts := []ports.CreateOptsBuilder{
+		ports.CreateOpts{
+			Name:         "private-port-1",
+			AdminStateUp: &asu,
+			NetworkID:    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+		ports.CreateOpts{
+			Name:         "private-port-2",
+			AdminStateUp: &asu,
+			NetworkID:    "a87cc70a-3e15-4acf-8205-9b711a3531b7",
+		},
+	}
+
+	p, err := ports.BulkCreate(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(p))
+	th.AssertEquals(t, "65c0ee9f-d634-4522-8954-51021b570b0d", p[0].ID)
+	th.AssertEquals(t, "private-port-1", p[0].Name)
+	th.AssertEquals(t, "b1a3c5d7-2f4e-4a6b-8c9d-0e1f2a3b4c5d", p[1].ID)
+	th.AssertDeepEquals(t, []ports.IP{
+		{SubnetID: "a0304c3a-4f08-4c43-88af-d796509c97d2", IPAddress: "10.0.0.3"},
+	}, p[1].FixedIPs)
+}
+
+func TestBulkCreateNotSupported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		fmt.Fprintf(w, `{"NeutronError": {"type": "HTTPBadRequest", "message": "Bulk operation not supported", "detail": ""}}`)
+	})
+
+	opts := []ports.CreateOptsBuilder{
+		ports.CreateOpts{NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7"},
+	}
+
+	_, err := ports.BulkCreate(fake.ServiceClient(), opts).Extract()
+	e, ok := err.(bulk.ErrNotSupported)
+	if !ok {
+		t.Fatalf("Expected bulk.ErrNotSupported, got %v", err)
+	}
+	th.AssertEquals(t, "ports", e.Resource)
+	if _, ok := e.Err.(gophercloud.ErrDefault400); !ok {
+		t.Fatalf("Expected the original 400 error to be kept, got %v", e.Err)
+	}
+}
+
+func TestBulkCreateOtherError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/ports", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		fmt.Fprintf(w, `{"NeutronError": {"type": "InvalidInput", "message": "Invalid input for operation", "detail": ""}}`)
+	})
+
+	opts := []ports.CreateOptsBuilder{
+		ports.CreateOpts{NetworkID: "a87cc70a-3e15-4acf-8205-9b711a3531b7"},
+	}
+
+	_, err := ports.BulkCreate(fake.ServiceClient(), opts).Extract()
+	if err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+	if _, ok := err.(bulk.ErrNotSupported); ok {
+		t.Fatalf("Expected a plain 400 error, got %v", err)
+	}
+}
@@ -94,6 +94,26 @@ Example to Create a Subnet With a Default Gateway
 		panic(err)
 	}
 
+Example to Create Several Subnets in a Single Request
+
+	createOpts := []subnets.CreateOptsBuilder{
+		subnets.CreateOpts{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 4,
+			CIDR:      "192.168.199.0/24",
+		},
+		subnets.CreateOpts{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 4,
+			CIDR:      "192.168.200.0/24",
+		},
+	}
+
+	allSubnets, err := subnets.BulkCreate(networkClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
 Example to Update a Subnet
 
 	subnetID := "db77d064-e34f-4d06-b060-f21e28a61c23"
@@ -2,6 +2,7 @@ package subnets
 
 import (
 	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/internal"
 	"github.com/chjlangzi/gophercloud/pagination"
 )
 
@@ -29,10 +30,15 @@ type ListOpts struct {
 	IPv6RAMode      string `q:"ipv6_ra_mode"`
 	ID              string `q:"id"`
 	SubnetPoolID    string `q:"subnetpool_id"`
+	SegmentID       string `q:"segment_id"`
 	Limit           int    `q:"limit"`
 	Marker          string `q:"marker"`
 	SortKey         string `q:"sort_key"`
 	SortDir         string `q:"sort_dir"`
+	Tags            string `q:"tags"`
+	TagsAny         string `q:"tags-any"`
+	NotTags         string `q:"not-tags"`
+	NotTagsAny      string `q:"not-tags-any"`
 }
 
 // ToSubnetListQuery formats a ListOpts into a query string.
@@ -123,6 +129,10 @@ type CreateOpts struct {
 
 	// SubnetPoolID is the id of the subnet pool that subnet should be associated to.
 	SubnetPoolID string `json:"subnetpool_id,omitempty"`
+
+	// SegmentID is the id of the network segment the subnet should be
+	// associated to, on a routed provider network.
+	SegmentID string `json:"segment_id,omitempty"`
 }
 
 // ToSubnetCreateMap builds a request body from CreateOpts.
@@ -152,6 +162,24 @@ func Create(c *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResul
 	return
 }
 
+// BulkCreate creates all the subnets described by opts in a single request.
+// Either all of them are created or, if an error is returned, none of them.
+// A bulk.ErrNotSupported is returned if the Networking service has bulk
+// operations disabled.
+func BulkCreate(c *gophercloud.ServiceClient, opts []CreateOptsBuilder) (r BulkCreateResult) {
+	bodies := make([]map[string]interface{}, len(opts))
+	for i, opt := range opts {
+		b, err := opt.ToSubnetCreateMap()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		bodies[i] = b
+	}
+	r.Err = internal.BulkCreate(c, createURL(c), "subnet", "subnets", bodies, &r.Body)
+	return
+}
+
 // UpdateOptsBuilder allows extensions to add additional parameters to the
 // Update request.
 type UpdateOptsBuilder interface {
@@ -180,6 +208,11 @@ type UpdateOpts struct {
 
 	// EnableDHCP will either enable to disable the DHCP service.
 	EnableDHCP *bool `json:"enable_dhcp,omitempty"`
+
+	// SegmentID associates the subnet with a network segment. A subnet which
+	// is not associated with a segment yet can be moved to one, e.g. when
+	// converting a network to a routed provider network.
+	SegmentID *string `json:"segment_id,omitempty"`
 }
 
 // ToSubnetUpdateMap builds a request body from UpdateOpts.
@@ -36,6 +36,24 @@ type UpdateResult struct {
 	commonResult
 }
 
+// BulkCreateResult represents the result of a bulk create operation. Call its
+// Extract method to interpret it as a slice of Subnets.
+type BulkCreateResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the subnets
+// created by BulkCreate, in the order of the request.
+func (r BulkCreateResult) Extract() ([]Subnet, error) {
+	var s []Subnet
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func (r BulkCreateResult) ExtractInto(v interface{}) error {
+	return r.Result.ExtractIntoSlicePtr(v, "subnets")
+}
+
 // DeleteResult represents the result of a delete operation. Call its
 // ExtractErr method to determine if the request succeeded or failed.
 type DeleteResult struct {
@@ -106,6 +124,13 @@ type Subnet struct {
 
 	// SubnetPoolID is the id of the subnet pool associated with the subnet.
 	SubnetPoolID string `json:"subnetpool_id"`
+
+	// SegmentID is the id of the network segment the subnet is associated
+	// with, on a routed provider network.
+	SegmentID string `json:"segment_id"`
+
+	// Tags optionally set via extensions/attributestags
+	Tags []string `json:"tags"`
 }
 
 // SubnetPage is the page returned by a pager when traversing over a collection
@@ -554,3 +554,109 @@ const SubnetUpdateAllocationPoolResponse = `
     }
 }
 `
+
+const SubnetCreateWithSegmentRequest = `
+{
+    "subnet": {
+        "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+        "ip_version": 4,
+        "cidr": "192.168.199.0/24",
+        "segment_id": "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4"
+    }
+}
+`
+
+const SubnetCreateWithSegmentResponse = `
+{
+    "subnet": {
+        "name": "",
+        "enable_dhcp": true,
+        "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+        "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+        "dns_nameservers": [],
+        "allocation_pools": [
+            {
+                "start": "192.168.199.2",
+                "end": "192.168.199.254"
+            }
+        ],
+        "host_routes": [],
+        "ip_version": 4,
+        "gateway_ip": "192.168.199.1",
+        "cidr": "192.168.199.0/24",
+        "id": "3b80198d-4f7b-4f77-9ef5-774d54e17126",
+        "segment_id": "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4"
+    }
+}
+`
+
+const SubnetUpdateSegmentRequest = `
+{
+    "subnet": {
+        "segment_id": "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4"
+    }
+}
+`
+
+const SubnetBulkCreateRequest = `
+{
+    "subnets": [
+        {
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "ip_version": 4,
+            "cidr": "192.168.199.0/24",
+            "name": "subnet_1"
+        },
+        {
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "ip_version": 4,
+            "cidr": "192.168.200.0/24",
+            "gateway_ip": null,
+            "name": "subnet_2"
+        }
+    ]
+}
+`
+
+const SubnetBulkCreateResult = `
+{
+    "subnets": [
+        {
+            "name": "subnet_1",
+            "enable_dhcp": true,
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+            "dns_nameservers": [],
+            "allocation_pools": [
+                {
+                    "start": "192.168.199.2",
+                    "end": "192.168.199.254"
+                }
+            ],
+            "host_routes": [],
+            "ip_version": 4,
+            "gateway_ip": "192.168.199.1",
+            "cidr": "192.168.199.0/24",
+            "id": "3b80198d-4f7b-4f77-9ef5-774d54e17126"
+        },
+        {
+            "name": "subnet_2",
+            "enable_dhcp": true,
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+            "dns_nameservers": [],
+            "allocation_pools": [
+                {
+                    "start": "192.168.200.1",
+                    "end": "192.168.200.254"
+                }
+            ],
+            "host_routes": [],
+            "ip_version": 4,
+            "gateway_ip": null,
+            "cidr": "192.168.200.0/24",
+            "id": "1b7e2bc4-5c8c-47a0-9a4b-dca2ba1f0c3d"
+        }
+    ]
+}
+`
@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/bulk"
 	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
 	"github.com/chjlangzi/gophercloud/openstack/networking/v2/subnets"
 	"github.com/chjlangzi/gophercloud/pagination"
@@ -559,3 +561,143 @@ func TestDelete(t *testing.T) {
 	res := subnets.Delete(fake.ServiceClient(), "08eae331-0402-425a-923c-34f7cfe39c1b")
 	th.AssertNoErr(t, res.Err)
 }
+
+func TestCreateWithSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetCreateWithSegmentRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, SubnetCreateWithSegmentResponse)
+	})
+
+	opts := subnets.CreateOpts{
+		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+		IPVersion: 4,
+		CIDR:      "192.168.199.0/24",
+		SegmentID: "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4",
+	}
+	s, err := subnets.Create(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, s.SegmentID, "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4")
+}
+
+func TestUpdateSegment(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets/3b80198d-4f7b-4f77-9ef5-774d54e17126", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetUpdateSegmentRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, SubnetCreateWithSegmentResponse)
+	})
+
+	segmentID := "70d1fd8c-0f1a-4ee0-a4c7-8bd6a5e9b7e4"
+	opts := subnets.UpdateOpts{
+		SegmentID: &segmentID,
+	}
+	s, err := subnets.Update(fake.ServiceClient(), "3b80198d-4f7b-4f77-9ef5-774d54e17126", opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, s.SegmentID, segmentID)
+}
+
+func TestBulkCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, SubnetBulkCreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, SubnetBulkCreateResult)
+	})
+
+	var noGateway = ""
+	opts := []subnets.CreateOptsBuilder{
+		subnets.CreateOpts{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 4,
+			CIDR:      "192.168.199.0/24",
+			Name:      "subnet_1",
+		},
+		subnets.CreateOpts{
+			NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+			IPVersion: 4,
+			CIDR:      "192.168.200.0/24",
+			GatewayIP: &noGateway,
+			Name:      "subnet_2",
+		},
+	}
+
+	s, err := subnets.BulkCreate(fake.ServiceClient(), opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, 2, len(s))
+	th.AssertEquals(t, "3b80198d-4f7b-4f77-9ef5-774d54e17126", s[0].ID)
+	th.AssertEquals(t, "192.168.199.1", s[0].GatewayIP)
+	th.AssertEquals(t, "1b7e2bc4-5c8c-47a0-9a4b-dca2ba1f0c3d", s[1].ID)
+	th.AssertEquals(t, "", s[1].GatewayIP)
+}
+
+func TestBulkCreateNotSupported(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		fmt.Fprintf(w, `{"NeutronError": {"type": "HTTPBadRequest", "message": "Bulk operation not supported", "detail": ""}}`)
+	})
+
+	opts := []subnets.CreateOptsBuilder{
+		subnets.CreateOpts{NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22", IPVersion: 4, CIDR: "192.168.199.0/24"},
+	}
+
+	_, err := subnets.BulkCreate(fake.ServiceClient(), opts).Extract()
+	e, ok := err.(bulk.ErrNotSupported)
+	if !ok {
+		t.Fatalf("Expected bulk.ErrNotSupported, got %v", err)
+	}
+	th.AssertEquals(t, "subnets", e.Resource)
+	if _, ok := e.Err.(gophercloud.ErrDefault400); !ok {
+		t.Fatalf("Expected the original 400 error to be kept, got %v", e.Err)
+	}
+}
+
+func TestBulkCreateInvalidOpts(t *testing.T) {
+	opts := []subnets.CreateOptsBuilder{
+		subnets.CreateOpts{NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22", IPVersion: 4, CIDR: "192.168.199.0/24"},
+		subnets.CreateOpts{IPVersion: 4, CIDR: "192.168.200.0/24"},
+	}
+
+	res := subnets.BulkCreate(fake.ServiceClient(), opts)
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
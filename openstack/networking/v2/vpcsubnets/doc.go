@@ -1,26 +1,23 @@
 /*
-Package subnets contains functionality for working with Neutron subnet
-resources. A subnet represents an IP address block that can be used to
-assign IP addresses to virtual instances. Each subnet must have a CIDR and
-must be associated with a network. IPs can either be selected from the whole
-subnet CIDR or from allocation pools specified by the user.
+Package vpcsubnets contains functionality for working with the subnets of a
+vpc. A subnet represents an IP address block that can be used to assign IP
+addresses to virtual instances. Each subnet must have a CIDR and belongs to
+the vpc it is created in.
 
 A subnet can also have a gateway, a list of DNS name servers, and host routes.
 This information is pushed to instances whose interfaces are associated with
 the subnet.
 
-Example to List Subnets
+Example to List Subnets of a Vpc
 
-	listOpts := subnets.ListOpts{
-		IPVersion: 4,
-	}
+	vpcID := "d32019d3-bc6e-4319-9c1d-6722fc136a22"
 
-	allPages, err := subnets.List(networkClient, listOpts).AllPages()
+	allPages, err := vpcsubnets.List(networkClient, vpcID, vpcsubnets.ListOpts{}).AllPages()
 	if err != nil {
 		panic(err)
 	}
 
-	allSubnets, err := subnets.ExtractSubnets(allPages)
+	allSubnets, err := vpcsubnets.ExtractSubnets(allPages)
 	if err != nil {
 		panic(err)
 	}
@@ -29,103 +26,97 @@ Example to List Subnets
 		fmt.Printf("%+v\n", subnet)
 	}
 
-Example to Create a Subnet With Specified Gateway
+Example to Get a Subnet of a Vpc
 
-	var gatewayIP = "192.168.199.1"
-	createOpts := subnets.CreateOpts{
-		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a22",
-		IPVersion: 4,
-		CIDR:      "192.168.199.0/24",
-		GatewayIP: &gatewayIP,
-		AllocationPools: []subnets.AllocationPool{
-		  {
-		    Start: "192.168.199.2",
-		    End:   "192.168.199.254",
-		  },
-		},
-		DNSNameservers: []string{"foo"},
-	}
+	vpcID := "d32019d3-bc6e-4319-9c1d-6722fc136a22"
+	subnetID := "db77d064-e34f-4d06-b060-f21e28a61c23"
 
-	subnet, err := subnets.Create(networkClient, createOpts).Extract()
+	subnet, err := vpcsubnets.Get(networkClient, vpcID, subnetID).Extract()
 	if err != nil {
 		panic(err)
 	}
 
-Example to Create a Subnet With No Gateway
+Example to Create a Subnet With Specified Gateway
 
-	var noGateway = ""
+	vpcID := "d32019d3-bc6e-4319-9c1d-6722fc136a22"
 
-	createOpts := subnets.CreateOpts{
-		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a23",
-		IPVersion: 4,
-		CIDR:      "192.168.1.0/24",
-		GatewayIP: &noGateway,
-		AllocationPools: []subnets.AllocationPool{
-			{
-				Start: "192.168.1.2",
-				End:   "192.168.1.254",
-			},
-		},
-		DNSNameservers: []string{},
+	var gatewayIP = "192.168.199.1"
+	enableDHCP := true
+	createOpts := vpcsubnets.CreateOpts{
+		Name:           "subnet_1",
+		IPVersion:      4,
+		CIDR:           "192.168.199.0/24",
+		GatewayIP:      &gatewayIP,
+		EnableDHCP:     &enableDHCP,
+		DNSNameservers: []string{"8.8.8.8"},
 	}
 
-	subnet, err := subnets.Create(networkClient, createOpts).Extract()
+	subnet, err := vpcsubnets.Create(networkClient, vpcID, createOpts).Extract()
 	if err != nil {
 		panic(err)
 	}
 
-Example to Create a Subnet With a Default Gateway
-
-	createOpts := subnets.CreateOpts{
-		NetworkID: "d32019d3-bc6e-4319-9c1d-6722fc136a23",
-		IPVersion: 4,
-		CIDR:      "192.168.1.0/24",
-		AllocationPools: []subnets.AllocationPool{
-			{
-				Start: "192.168.1.2",
-				End:   "192.168.1.254",
-			},
-		},
-		DNSNameservers: []string{},
-	}
-
-	subnet, err := subnets.Create(networkClient, createOpts).Extract()
+	err = vpcsubnets.WaitForStatus(networkClient, vpcID, subnet.ID, "ACTIVE", 60)
 	if err != nil {
 		panic(err)
 	}
 
 Example to Update a Subnet
 
+	vpcID := "d32019d3-bc6e-4319-9c1d-6722fc136a22"
 	subnetID := "db77d064-e34f-4d06-b060-f21e28a61c23"
 
-	updateOpts := subnets.UpdateOpts{
+	dnsNameservers := []string{"8.8.8.8", "8.8.4.4"}
+	enableDHCP := false
+	updateOpts := vpcsubnets.UpdateOpts{
 		Name:           "new_name",
-		DNSNameservers: []string{"8.8.8.8},
+		DNSNameservers: &dnsNameservers,
+		EnableDHCP:     &enableDHCP,
 	}
 
-	subnet, err := subnets.Update(networkClient, subnetID, updateOpts).Extract()
+	subnet, err := vpcsubnets.Update(networkClient, vpcID, subnetID, updateOpts).Extract()
 	if err != nil {
 		panic(err)
 	}
 
 Example to Remove a Gateway From a Subnet
 
-	var noGateway = ""
+	vpcID := "d32019d3-bc6e-4319-9c1d-6722fc136a22"
 	subnetID := "db77d064-e34f-4d06-b060-f21e28a61c23"
 
-	updateOpts := subnets.UpdateOpts{
+	var noGateway = ""
+	updateOpts := vpcsubnets.UpdateOpts{
 		GatewayIP: &noGateway,
 	}
 
-	subnet, err := subnets.Update(networkClient, subnetID, updateOpts).Extract()
+	subnet, err := vpcsubnets.Update(networkClient, vpcID, subnetID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Create a Vpc With Subnets
+
+	vpcOpts := vpcs.CreateOpts{
+		Name: "vpc_1",
+		Cidr: "192.168.0.0/16",
+	}
+
+	subnetOpts := []vpcsubnets.CreateOptsBuilder{
+		vpcsubnets.CreateOpts{Name: "subnet_1", IPVersion: 4, CIDR: "192.168.1.0/24"},
+		vpcsubnets.CreateOpts{Name: "subnet_2", IPVersion: 4, CIDR: "192.168.2.0/24"},
+	}
+
+	vpc, subnets, err := vpcsubnets.CreateVpcWithSubnets(networkClient, vpcOpts, subnetOpts)
 	if err != nil {
 		panic(err)
 	}
 
 Example to Delete a Subnet
 
+	vpcID := "d32019d3-bc6e-4319-9c1d-6722fc136a22"
 	subnetID := "db77d064-e34f-4d06-b060-f21e28a61c23"
-	err := subnets.Delete(networkClient, subnetID).ExtractErr()
+
+	err := vpcsubnets.Delete(networkClient, vpcID, subnetID).ExtractErr()
 	if err != nil {
 		panic(err)
 	}
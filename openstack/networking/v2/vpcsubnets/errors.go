@@ -0,0 +1,33 @@
+package vpcsubnets
+
+import (
+	"fmt"
+
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ErrSubnetInError is returned by WaitForStatus when the subnet goes to
+// ERROR while waiting for another status.
+type ErrSubnetInError struct {
+	gophercloud.BaseError
+	VpcID    string
+	SubnetID string
+}
+
+func (e ErrSubnetInError) Error() string {
+	return fmt.Sprintf("Subnet %s of vpc %s is in ERROR status", e.SubnetID, e.VpcID)
+}
+
+// ErrRollbackFailed is returned by CreateVpcWithSubnets when a step failed
+// and some of the resources created so far could not be deleted afterwards.
+// Cause is the error of the failed step and Leftovers holds the IDs of the
+// resources which were left behind.
+type ErrRollbackFailed struct {
+	gophercloud.BaseError
+	Cause     error
+	Leftovers []string
+}
+
+func (e ErrRollbackFailed) Error() string {
+	return fmt.Sprintf("Unable to roll back after %v, resources left behind: %v", e.Cause, e.Leftovers)
+}
@@ -3,8 +3,6 @@ package vpcsubnets
 import (
 	"github.com/chjlangzi/gophercloud"
 	"github.com/chjlangzi/gophercloud/pagination"
-	"fmt"
-	"encoding/json"
 )
 
 // ListOptsBuilder allows extensions to add additional parameters to the
@@ -49,6 +47,12 @@ func List(c *gophercloud.ServiceClient,vpcId string, opts ListOptsBuilder) pagin
 	})
 }
 
+// Get retrieves a specific subnet of a vpc based on its unique ID.
+func Get(c *gophercloud.ServiceClient, vpcId string, subnetId string) (r GetResult) {
+	_, r.Err = c.Get(getURL(c, vpcId, subnetId), &r.Body, nil)
+	return
+}
+
 // CreateOptsBuilder allows extensions to add additional parameters to the
 // List request.
 type CreateOptsBuilder interface {
@@ -76,6 +80,12 @@ type CreateOpts struct {
 	// DNSNameservers are the nameservers to be set via DHCP.
 	DNSNameservers []string `json:"dns_nameservers,omitempty"`
 
+	// EnableDHCP will either enable to disable the DHCP service.
+	EnableDHCP *bool `json:"enable_dhcp,omitempty"`
+
+	// Description is a human-readable description of the subnet.
+	Description string `json:"description,omitempty"`
+
 	Vpc *bool `json:"vpc"`
 }
 
@@ -102,13 +112,69 @@ func Create(c *gophercloud.ServiceClient,vpcId string, opts CreateOptsBuilder) (
 		r.Err = err
 		return
 	}
-	jsonBytes, _ := json.Marshal(b)
-	fmt.Println("subnet>>>>%s:", string(jsonBytes))
-	fmt.Println("url>>%s,",createURL(c,vpcId))
 	_, r.Err = c.Post(createURL(c,vpcId), b, &r.Body, nil)
 	return
 }
 
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToSubnetUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents the attributes used when updating an existing subnet.
+type UpdateOpts struct {
+	// Name is a human-readable name of the subnet.
+	Name string `json:"name,omitempty"`
+
+	// Description is a human-readable description of the subnet.
+	Description *string `json:"description,omitempty"`
+
+	// GatewayIP sets gateway information for the subnet. Setting to nil will
+	// leave the gateway as it is. Setting to an empty string will remove the
+	// gateway from the subnet. Setting to an explicit address will set that
+	// address as the gateway.
+	GatewayIP *string `json:"gateway_ip,omitempty"`
+
+	// DNSNameservers are the nameservers to be set via DHCP. Setting to a
+	// pointer of an empty slice will remove all nameservers.
+	DNSNameservers *[]string `json:"dns_nameservers,omitempty"`
+
+	// HostRoutes are any static host routes to be set via DHCP.
+	HostRoutes *[]HostRoute `json:"host_routes,omitempty"`
+
+	// EnableDHCP will either enable to disable the DHCP service.
+	EnableDHCP *bool `json:"enable_dhcp,omitempty"`
+}
+
+// ToSubnetUpdateMap builds a request body from UpdateOpts.
+func (opts UpdateOpts) ToSubnetUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "subnet")
+	if err != nil {
+		return nil, err
+	}
+
+	if m := b["subnet"].(map[string]interface{}); m["gateway_ip"] == "" {
+		m["gateway_ip"] = nil
+	}
+
+	return b, nil
+}
+
+// Update accepts a UpdateOpts struct and updates an existing subnet of a vpc
+// using the values provided.
+func Update(c *gophercloud.ServiceClient, vpcId string, subnetId string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToSubnetUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = c.Put(updateURL(c, vpcId, subnetId), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
 // Delete accepts a unique ID and deletes the subnet associated with it.
 func Delete(c *gophercloud.ServiceClient, vpcId string, subnetId string) (r DeleteResult) {
 	_, r.Err = c.Delete(deleteURL(c, vpcId, subnetId), nil)
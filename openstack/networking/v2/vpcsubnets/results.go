@@ -68,6 +68,9 @@ type Subnet struct {
 	// Human-readable name for the subnet. Might not be unique.
 	Name string `json:"name"`
 
+	// Status is the status of the subnet, e.g. `ACTIVE', `BUILD' or `ERROR'.
+	Status string `json:"status"`
+
 	// IP version, either `4' or `6'.
 	IPVersion int `json:"ip_version"`
 
@@ -94,6 +97,9 @@ type Subnet struct {
 	// TenantID is the project owner of the subnet.
 	TenantID string `json:"tenant_id"`
 
+	// ProjectID is the project owner of the subnet.
+	ProjectID string `json:"project_id"`
+
 	// The IPv6 address modes specifies mechanisms for assigning IPv6 IP addresses.
 	IPv6AddressMode string `json:"ipv6_address_mode"`
 
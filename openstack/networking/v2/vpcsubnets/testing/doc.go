@@ -0,0 +1,2 @@
+// vpcsubnets unit tests
+package testing
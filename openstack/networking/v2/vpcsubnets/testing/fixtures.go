@@ -0,0 +1,182 @@
+package testing
+
+import (
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/vpcsubnets"
+)
+
+const ListResponse = `
+{
+    "subnets": [
+        {
+            "name": "private-subnet",
+            "status": "ACTIVE",
+            "enable_dhcp": true,
+            "network_id": "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+            "tenant_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "project_id": "26a7980765d0414dbc1fc1f88cdb7e6e",
+            "dns_nameservers": [],
+            "allocation_pools": [
+                {
+                    "start": "10.0.0.2",
+                    "end": "10.0.0.254"
+                }
+            ],
+            "host_routes": [],
+            "ip_version": 4,
+            "gateway_ip": "10.0.0.1",
+            "cidr": "10.0.0.0/24",
+            "id": "08eae331-0402-425a-923c-34f7cfe39c1b"
+        },
+        {
+            "name": "my_subnet",
+            "status": "ACTIVE",
+            "enable_dhcp": false,
+            "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+            "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+            "project_id": "4fd44f30292945e481c7b8a0c8908869",
+            "dns_nameservers": ["8.8.8.8"],
+            "allocation_pools": [
+                {
+                    "start": "192.0.0.2",
+                    "end": "192.255.255.254"
+                }
+            ],
+            "host_routes": [],
+            "ip_version": 4,
+            "gateway_ip": "192.0.0.1",
+            "cidr": "192.0.0.0/8",
+            "id": "54d6f61d-db07-451c-9ab3-b9609b6b6f0b"
+        }
+    ]
+}
+`
+
+var Subnet1 = vpcsubnets.Subnet{
+	Name:           "private-subnet",
+	Status:         "ACTIVE",
+	EnableDHCP:     true,
+	NetworkID:      "db193ab3-96e3-4cb3-8fc5-05f4296d0324",
+	TenantID:       "26a7980765d0414dbc1fc1f88cdb7e6e",
+	ProjectID:      "26a7980765d0414dbc1fc1f88cdb7e6e",
+	DNSNameservers: []string{},
+	AllocationPools: []vpcsubnets.AllocationPool{
+		{
+			Start: "10.0.0.2",
+			End:   "10.0.0.254",
+		},
+	},
+	HostRoutes: []vpcsubnets.HostRoute{},
+	IPVersion:  4,
+	GatewayIP:  "10.0.0.1",
+	CIDR:       "10.0.0.0/24",
+	ID:         "08eae331-0402-425a-923c-34f7cfe39c1b",
+}
+
+var Subnet2 = vpcsubnets.Subnet{
+	Name:           "my_subnet",
+	Status:         "ACTIVE",
+	EnableDHCP:     false,
+	NetworkID:      "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+	TenantID:       "4fd44f30292945e481c7b8a0c8908869",
+	ProjectID:      "4fd44f30292945e481c7b8a0c8908869",
+	DNSNameservers: []string{"8.8.8.8"},
+	AllocationPools: []vpcsubnets.AllocationPool{
+		{
+			Start: "192.0.0.2",
+			End:   "192.255.255.254",
+		},
+	},
+	HostRoutes: []vpcsubnets.HostRoute{},
+	IPVersion:  4,
+	GatewayIP:  "192.0.0.1",
+	CIDR:       "192.0.0.0/8",
+	ID:         "54d6f61d-db07-451c-9ab3-b9609b6b6f0b",
+}
+
+const GetResponse = `
+{
+    "subnet": {
+        "name": "my_subnet",
+        "status": "ACTIVE",
+        "enable_dhcp": false,
+        "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+        "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+        "project_id": "4fd44f30292945e481c7b8a0c8908869",
+        "dns_nameservers": ["8.8.8.8"],
+        "allocation_pools": [
+            {
+                "start": "192.0.0.2",
+                "end": "192.255.255.254"
+            }
+        ],
+        "host_routes": [],
+        "ip_version": 4,
+        "gateway_ip": "192.0.0.1",
+        "cidr": "192.0.0.0/8",
+        "id": "54d6f61d-db07-451c-9ab3-b9609b6b6f0b"
+    }
+}
+`
+
+const CreateRequest = `
+{
+    "subnet": {
+        "name": "my_subnet",
+        "ip_version": 4,
+        "cidr": "192.0.0.0/8",
+        "gateway_ip": "192.0.0.1",
+        "enable_dhcp": false,
+        "dns_nameservers": ["8.8.8.8"],
+        "vpc": true
+    }
+}
+`
+
+const UpdateRequest = `
+{
+    "subnet": {
+        "name": "my_new_subnet",
+        "gateway_ip": null,
+        "dns_nameservers": [],
+        "enable_dhcp": true
+    }
+}
+`
+
+const UpdateResponse = `
+{
+    "subnet": {
+        "name": "my_new_subnet",
+        "status": "ACTIVE",
+        "enable_dhcp": true,
+        "network_id": "d32019d3-bc6e-4319-9c1d-6722fc136a22",
+        "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+        "project_id": "4fd44f30292945e481c7b8a0c8908869",
+        "dns_nameservers": [],
+        "allocation_pools": [
+            {
+                "start": "192.0.0.2",
+                "end": "192.255.255.254"
+            }
+        ],
+        "host_routes": [],
+        "ip_version": 4,
+        "gateway_ip": null,
+        "cidr": "192.0.0.0/8",
+        "id": "54d6f61d-db07-451c-9ab3-b9609b6b6f0b"
+    }
+}
+`
+
+const VpcCreateResponse = `
+{
+    "vpc": {
+        "status": "ACTIVE",
+        "network_ids": [],
+        "name": "my_vpc",
+        "tenant_id": "4fd44f30292945e481c7b8a0c8908869",
+        "id": "4e8e5957-649f-477b-9e5b-f1f75b21c03c",
+        "cidr": "192.0.0.0/8"
+    }
+}
+`
@@ -0,0 +1,251 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	fake "github.com/chjlangzi/gophercloud/openstack/networking/v2/common"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/vpcs"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/vpcsubnets"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, ListResponse)
+	})
+
+	allPages, err := vpcsubnets.List(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", vpcsubnets.ListOpts{}).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := vpcsubnets.ExtractSubnets(allPages)
+	th.AssertNoErr(t, err)
+
+	th.CheckDeepEquals(t, []vpcsubnets.Subnet{Subnet1, Subnet2}, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c/subnets/54d6f61d-db07-451c-9ab3-b9609b6b6f0b", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	s, err := vpcsubnets.Get(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", "54d6f61d-db07-451c-9ab3-b9609b6b6f0b").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Subnet2, s)
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, CreateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	iTrue := true
+	iFalse := false
+	gatewayIP := "192.0.0.1"
+	opts := vpcsubnets.CreateOpts{
+		Name:           "my_subnet",
+		IPVersion:      4,
+		CIDR:           "192.0.0.0/8",
+		GatewayIP:      &gatewayIP,
+		EnableDHCP:     &iFalse,
+		DNSNameservers: []string{"8.8.8.8"},
+		Vpc:            &iTrue,
+	}
+
+	s, err := vpcsubnets.Create(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &Subnet2, s)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c/subnets/54d6f61d-db07-451c-9ab3-b9609b6b6f0b", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestHeader(t, r, "Content-Type", "application/json")
+		th.TestHeader(t, r, "Accept", "application/json")
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, UpdateResponse)
+	})
+
+	iTrue := true
+	noGateway := ""
+	dnsNameservers := []string{}
+	opts := vpcsubnets.UpdateOpts{
+		Name:           "my_new_subnet",
+		GatewayIP:      &noGateway,
+		DNSNameservers: &dnsNameservers,
+		EnableDHCP:     &iTrue,
+	}
+
+	s, err := vpcsubnets.Update(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", "54d6f61d-db07-451c-9ab3-b9609b6b6f0b", opts).Extract()
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "my_new_subnet", s.Name)
+	th.AssertEquals(t, "", s.GatewayIP)
+	th.AssertEquals(t, true, s.EnableDHCP)
+	th.AssertDeepEquals(t, []string{}, s.DNSNameservers)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c/subnets/54d6f61d-db07-451c-9ab3-b9609b6b6f0b", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	res := vpcsubnets.Delete(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", "54d6f61d-db07-451c-9ab3-b9609b6b6f0b")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestWaitForStatusError(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c/subnets/54d6f61d-db07-451c-9ab3-b9609b6b6f0b", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		fmt.Fprintf(w, `{"subnet": {"id": "54d6f61d-db07-451c-9ab3-b9609b6b6f0b", "status": "ERROR"}}`)
+	})
+
+	err := vpcsubnets.WaitForStatus(fake.ServiceClient(), "4e8e5957-649f-477b-9e5b-f1f75b21c03c", "54d6f61d-db07-451c-9ab3-b9609b6b6f0b", "ACTIVE", 5)
+	if _, ok := err.(vpcsubnets.ErrSubnetInError); !ok {
+		t.Fatalf("Expected ErrSubnetInError, got %v", err)
+	}
+}
+
+func TestCreateVpcWithSubnets(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/v2.0/vpcs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, VpcCreateResponse)
+	})
+
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	subnetOpts := []vpcsubnets.CreateOptsBuilder{
+		vpcsubnets.CreateOpts{Name: "my_subnet", IPVersion: 4, CIDR: "192.0.0.0/8"},
+	}
+
+	vpc, subnets, err := vpcsubnets.CreateVpcWithSubnets(fake.ServiceClient(), vpcs.CreateOpts{Name: "my_vpc"}, subnetOpts)
+	th.AssertNoErr(t, err)
+
+	th.AssertEquals(t, "4e8e5957-649f-477b-9e5b-f1f75b21c03c", vpc.ID)
+	th.CheckDeepEquals(t, []vpcsubnets.Subnet{Subnet2}, subnets)
+}
+
+func TestCreateVpcWithSubnetsRollback(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	vpcDeleted := false
+	th.Mux.HandleFunc("/v2.0/vpcs", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, VpcCreateResponse)
+	})
+
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		vpcDeleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	subnetCreates := 0
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c/subnets", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+
+		subnetCreates++
+		if subnetCreates > 1 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		fmt.Fprintf(w, GetResponse)
+	})
+
+	subnetDeleted := false
+	th.Mux.HandleFunc("/v2.0/vpcs/4e8e5957-649f-477b-9e5b-f1f75b21c03c/subnets/54d6f61d-db07-451c-9ab3-b9609b6b6f0b", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		subnetDeleted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	subnetOpts := []vpcsubnets.CreateOptsBuilder{
+		vpcsubnets.CreateOpts{Name: "my_subnet", IPVersion: 4, CIDR: "192.0.0.0/8"},
+		vpcsubnets.CreateOpts{Name: "overlapping", IPVersion: 4, CIDR: "192.0.0.0/8"},
+	}
+
+	_, _, err := vpcsubnets.CreateVpcWithSubnets(fake.ServiceClient(), vpcs.CreateOpts{Name: "my_vpc"}, subnetOpts)
+	if err == nil {
+		t.Fatalf("Expected an error, got none")
+	}
+	if _, ok := err.(vpcsubnets.ErrRollbackFailed); ok {
+		t.Fatalf("Expected the create error, got %v", err)
+	}
+
+	th.AssertEquals(t, true, subnetDeleted)
+	th.AssertEquals(t, true, vpcDeleted)
+}
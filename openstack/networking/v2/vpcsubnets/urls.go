@@ -18,6 +18,14 @@ func createURL(c *gophercloud.ServiceClient, vpcId string) string {
 	return rootURL(c,vpcId)
 }
 
+func getURL(c *gophercloud.ServiceClient, vpcId string, subnetId string) string {
+	return resourceURL(c, vpcId, subnetId)
+}
+
+func updateURL(c *gophercloud.ServiceClient, vpcId string, subnetId string) string {
+	return resourceURL(c, vpcId, subnetId)
+}
+
 func deleteURL(c *gophercloud.ServiceClient, vpcId string, subnetId string) string {
 	return resourceURL(c,vpcId,subnetId)
 }
@@ -0,0 +1,74 @@
+package vpcsubnets
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/networking/v2/vpcs"
+)
+
+// WaitForStatus will continually poll a subnet of a vpc until it
+// successfully transitions to a specified status. It returns an
+// ErrSubnetInError as soon as the subnet goes to ERROR, unless ERROR is the
+// status waited for. It will do this for at most the number of seconds
+// specified.
+func WaitForStatus(c *gophercloud.ServiceClient, vpcId, subnetId, status string, secs int) error {
+	return gophercloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, vpcId, subnetId).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		if current.Status == "ERROR" {
+			return false, ErrSubnetInError{VpcID: vpcId, SubnetID: subnetId}
+		}
+
+		return false, nil
+	})
+}
+
+// CreateVpcWithSubnets creates a vpc and then each of its subnets in order.
+// If any step fails, the subnets created so far and the vpc are deleted
+// again, and the error of the failed step is returned. If the rollback itself
+// fails, an ErrRollbackFailed naming the resources left behind is returned
+// instead.
+func CreateVpcWithSubnets(c *gophercloud.ServiceClient, vpcOpts vpcs.CreateOptsBuilder, subnetOpts []CreateOptsBuilder) (*vpcs.Vpc, []Subnet, error) {
+	vpc, err := vpcs.Create(c, vpcOpts).Extract()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnets := make([]Subnet, 0, len(subnetOpts))
+	for _, opts := range subnetOpts {
+		subnet, err := Create(c, vpc.ID, opts).Extract()
+		if err != nil {
+			return nil, nil, rollback(c, vpc.ID, subnets, err)
+		}
+		subnets = append(subnets, *subnet)
+	}
+
+	return vpc, subnets, nil
+}
+
+// rollback deletes the given subnets in reverse order of creation and then
+// the vpc itself. It returns cause if everything was deleted.
+func rollback(c *gophercloud.ServiceClient, vpcId string, subnets []Subnet, cause error) error {
+	var leftovers []string
+	for i := len(subnets) - 1; i >= 0; i-- {
+		if err := Delete(c, vpcId, subnets[i].ID).ExtractErr(); err != nil {
+			leftovers = append(leftovers, subnets[i].ID)
+		}
+	}
+
+	if err := vpcs.Delete(c, vpcId).ExtractErr(); err != nil {
+		leftovers = append(leftovers, vpcId)
+	}
+
+	if len(leftovers) > 0 {
+		return ErrRollbackFailed{Cause: cause, Leftovers: leftovers}
+	}
+
+	return cause
+}
@@ -0,0 +1,27 @@
+/*
+Package allocationcandidates queries the OpenStack Placement service for the
+sets of resource providers that can satisfy a request for resources.
+
+Example to List Allocation Candidates
+
+	placementClient.Microversion = "1.29"
+
+	listOpts := allocationcandidates.ListOpts{
+		Resources: "VCPU:2,MEMORY_MB:2048,DISK_GB:20",
+		Required:  "HW_CPU_X86_AVX2",
+		Limit:     10,
+	}
+
+	candidates, err := allocationcandidates.List(placementClient, listOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for _, request := range candidates.AllocationRequests {
+		for rpID, allocation := range request.Allocations {
+			summary := candidates.ProviderSummaries[rpID]
+			fmt.Printf("%s: %+v (traits: %v)\n", rpID, allocation.Resources, summary.Traits)
+		}
+	}
+*/
+package allocationcandidates
@@ -0,0 +1,80 @@
+package allocationcandidates
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// GroupPolicy controls how the granular resource groups of a request may be
+// satisfied by resource providers.
+type GroupPolicy string
+
+const (
+	// GroupPolicyNone allows different granular groups to be satisfied by
+	// the same resource provider.
+	GroupPolicyNone GroupPolicy = "none"
+
+	// GroupPolicyIsolate requires different granular groups to be satisfied
+	// by different resource providers.
+	GroupPolicyIsolate GroupPolicy = "isolate"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToAllocationCandidateListQuery() (string, error)
+}
+
+// ListOpts describes the resources an allocation candidate must be able to
+// satisfy.
+type ListOpts struct {
+	// Resources is a comma-separated list of resource class and amount pairs,
+	// e.g. "VCPU:4,DISK_GB:64".
+	Resources string `q:"resources" required:"true"`
+
+	// Required is a comma-separated list of traits the resource providers
+	// must have, or must not have when prefixed with "!".
+	// Requires microversion 1.17 or later.
+	Required string `q:"required"`
+
+	// MemberOf is a string representing aggregate uuids the resource
+	// providers must be members of, e.g. "in:<uuid1>,<uuid2>".
+	// Requires microversion 1.21 or later.
+	MemberOf string `q:"member_of"`
+
+	// InTree restricts the candidates to the resource providers in the same
+	// tree as the given resource provider. Requires microversion 1.31 or
+	// later.
+	InTree string `q:"in_tree"`
+
+	// Limit is the maximum number of allocation requests to return.
+	// Requires microversion 1.16 or later.
+	Limit int `q:"limit"`
+
+	// GroupPolicy is required when more than one granular resource group is
+	// requested. Requires microversion 1.25 or later.
+	GroupPolicy GroupPolicy `q:"group_policy"`
+}
+
+// ToAllocationCandidateListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToAllocationCandidateListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List retrieves the allocation candidates able to satisfy the requested
+// resources.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) (r ListResult) {
+	url := listURL(client)
+
+	if opts != nil {
+		query, err := opts.ToAllocationCandidateListQuery()
+		if err != nil {
+			r.Err = err
+			return
+		}
+		url += query
+	}
+
+	_, r.Err = client.Get(url, &r.Body, nil)
+	return
+}
@@ -0,0 +1,74 @@
+package allocationcandidates
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// Allocation is the amount of each resource class that would be allocated
+// against a single resource provider.
+type Allocation struct {
+	Resources map[string]int `json:"resources"`
+}
+
+// AllocationRequest is a single way of satisfying the requested resources.
+// It can be passed to allocations.Update to claim the resources.
+type AllocationRequest struct {
+	// Allocations are the resources to allocate, keyed by resource provider
+	// uuid.
+	Allocations map[string]Allocation `json:"allocations"`
+
+	// Mappings maps each request group suffix to the resource providers that
+	// satisfy it. Requires microversion 1.34 or later.
+	Mappings map[string][]string `json:"mappings"`
+}
+
+// ResourceCapacity is the capacity and usage of a resource class of a
+// resource provider.
+type ResourceCapacity struct {
+	Capacity int `json:"capacity"`
+	Used     int `json:"used"`
+}
+
+// ProviderSummary describes a resource provider involved in one or more
+// allocation requests.
+type ProviderSummary struct {
+	// Resources are the capacity and usage of the resource provider, keyed
+	// by resource class.
+	Resources map[string]ResourceCapacity `json:"resources"`
+
+	// Traits are the traits of the resource provider.
+	// Requires microversion 1.17 or later.
+	Traits []string `json:"traits"`
+
+	// ParentProviderUUID is the uuid of the immediate parent of the resource
+	// provider. Requires microversion 1.29 or later.
+	ParentProviderUUID string `json:"parent_provider_uuid"`
+
+	// RootProviderUUID is the uuid of the top-most provider in the tree of
+	// the resource provider. Requires microversion 1.29 or later.
+	RootProviderUUID string `json:"root_provider_uuid"`
+}
+
+// AllocationCandidates are the allocation requests able to satisfy a query
+// along with a summary of the resource providers involved.
+type AllocationCandidates struct {
+	// AllocationRequests are the possible ways of satisfying the query.
+	AllocationRequests []AllocationRequest `json:"allocation_requests"`
+
+	// ProviderSummaries describe the resource providers referenced by the
+	// allocation requests, keyed by resource provider uuid.
+	ProviderSummaries map[string]ProviderSummary `json:"provider_summaries"`
+}
+
+// ListResult is the response from a List operation. Call its Extract method
+// to interpret it as AllocationCandidates.
+type ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ListResult as AllocationCandidates.
+func (r ListResult) Extract() (*AllocationCandidates, error) {
+	var s AllocationCandidates
+	err := r.ExtractInto(&s)
+	return &s, err
+}
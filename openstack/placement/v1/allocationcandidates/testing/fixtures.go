@@ -0,0 +1,127 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/allocationcandidates"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+const ListBody = `
+{
+  "allocation_requests": [
+    {
+      "allocations": {
+        "a99bad54-a275-4c4f-a8a3-ac00d57e5c64": {
+          "resources": {
+            "DISK_GB": 100
+          }
+        },
+        "35791f28-fb45-4717-9ea9-435b3ef7c3b3": {
+          "resources": {
+            "VCPU": 1,
+            "MEMORY_MB": 1024
+          }
+        }
+      },
+      "mappings": {
+        "": [
+          "a99bad54-a275-4c4f-a8a3-ac00d57e5c64",
+          "35791f28-fb45-4717-9ea9-435b3ef7c3b3"
+        ]
+      }
+    }
+  ],
+  "provider_summaries": {
+    "a99bad54-a275-4c4f-a8a3-ac00d57e5c64": {
+      "resources": {
+        "DISK_GB": {
+          "used": 0,
+          "capacity": 1900
+        }
+      },
+      "traits": ["MISC_SHARES_VIA_AGGREGATE"],
+      "parent_provider_uuid": null,
+      "root_provider_uuid": "a99bad54-a275-4c4f-a8a3-ac00d57e5c64"
+    },
+    "35791f28-fb45-4717-9ea9-435b3ef7c3b3": {
+      "resources": {
+        "VCPU": {
+          "used": 0,
+          "capacity": 384
+        },
+        "MEMORY_MB": {
+          "used": 0,
+          "capacity": 196608
+        }
+      },
+      "traits": ["HW_CPU_X86_SSE2", "HW_CPU_X86_AVX2"],
+      "parent_provider_uuid": null,
+      "root_provider_uuid": "35791f28-fb45-4717-9ea9-435b3ef7c3b3"
+    }
+  }
+}
+`
+
+var ExpectedAllocationCandidates = allocationcandidates.AllocationCandidates{
+	AllocationRequests: []allocationcandidates.AllocationRequest{
+		{
+			Allocations: map[string]allocationcandidates.Allocation{
+				"a99bad54-a275-4c4f-a8a3-ac00d57e5c64": {
+					Resources: map[string]int{
+						"DISK_GB": 100,
+					},
+				},
+				"35791f28-fb45-4717-9ea9-435b3ef7c3b3": {
+					Resources: map[string]int{
+						"VCPU":      1,
+						"MEMORY_MB": 1024,
+					},
+				},
+			},
+			Mappings: map[string][]string{
+				"": {
+					"a99bad54-a275-4c4f-a8a3-ac00d57e5c64",
+					"35791f28-fb45-4717-9ea9-435b3ef7c3b3",
+				},
+			},
+		},
+	},
+	ProviderSummaries: map[string]allocationcandidates.ProviderSummary{
+		"a99bad54-a275-4c4f-a8a3-ac00d57e5c64": {
+			Resources: map[string]allocationcandidates.ResourceCapacity{
+				"DISK_GB": {Used: 0, Capacity: 1900},
+			},
+			Traits:           []string{"MISC_SHARES_VIA_AGGREGATE"},
+			RootProviderUUID: "a99bad54-a275-4c4f-a8a3-ac00d57e5c64",
+		},
+		"35791f28-fb45-4717-9ea9-435b3ef7c3b3": {
+			Resources: map[string]allocationcandidates.ResourceCapacity{
+				"VCPU":      {Used: 0, Capacity: 384},
+				"MEMORY_MB": {Used: 0, Capacity: 196608},
+			},
+			Traits:           []string{"HW_CPU_X86_SSE2", "HW_CPU_X86_AVX2"},
+			RootProviderUUID: "35791f28-fb45-4717-9ea9-435b3ef7c3b3",
+		},
+	},
+}
+
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/allocation_candidates", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"resources":    "VCPU:1,MEMORY_MB:1024,DISK_GB:100",
+			"required":     "HW_CPU_X86_AVX2",
+			"limit":        "1",
+			"group_policy": "isolate",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListBody)
+	})
+}
@@ -0,0 +1,34 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/allocationcandidates"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListSuccessfully(t)
+
+	listOpts := allocationcandidates.ListOpts{
+		Resources:   "VCPU:1,MEMORY_MB:1024,DISK_GB:100",
+		Required:    "HW_CPU_X86_AVX2",
+		Limit:       1,
+		GroupPolicy: allocationcandidates.GroupPolicyIsolate,
+	}
+
+	actual, err := allocationcandidates.List(client.ServiceClient(), listOpts).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedAllocationCandidates, actual)
+}
+
+func TestListRequiresResources(t *testing.T) {
+	res := allocationcandidates.List(client.ServiceClient(), allocationcandidates.ListOpts{})
+	if res.Err == nil {
+		t.Fatalf("Expected error, got none")
+	}
+}
@@ -0,0 +1,7 @@
+package allocationcandidates
+
+import "github.com/chjlangzi/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("allocation_candidates")
+}
@@ -0,0 +1,49 @@
+/*
+Package allocations manages the allocations of a consumer, such as a server,
+against the resource providers of the OpenStack Placement service.
+
+Example to Get the Allocations of a Consumer
+
+	consumerID := "30a4a27e-ee7c-4f1e-9da5-a4a17cf3ad80"
+
+	allocations, err := allocations.Get(placementClient, consumerID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for rpID, allocation := range allocations.Allocations {
+		fmt.Printf("%s: %+v\n", rpID, allocation.Resources)
+	}
+
+Example to Update the Allocations of a Consumer
+
+	placementClient.Microversion = "1.28"
+
+	consumerGeneration := 1
+	updateOpts := allocations.UpdateOpts{
+		Allocations: map[string]allocations.Allocation{
+			"4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+				Resources: map[string]int{
+					"VCPU":      2,
+					"MEMORY_MB": 2048,
+				},
+			},
+		},
+		ProjectID:          "7e67cbf7-7c38-4a32-b85b-0739c690991a",
+		UserID:             "067f691e-725a-451a-83e2-5c3d13e1dffc",
+		ConsumerGeneration: &consumerGeneration,
+	}
+
+	err := allocations.Update(placementClient, consumerID, updateOpts).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete the Allocations of a Consumer
+
+	err := allocations.Delete(placementClient, consumerID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package allocations
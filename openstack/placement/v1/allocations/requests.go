@@ -0,0 +1,73 @@
+package allocations
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/utils"
+)
+
+// Get retrieves the allocations of a consumer, keyed by resource provider.
+func Get(client *gophercloud.ServiceClient, consumerID string) (r GetResult) {
+	_, r.Err = client.Get(allocationsURL(client, consumerID), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToAllocationUpdateMap() (map[string]interface{}, error)
+}
+
+// Allocation is the amount of each resource class a consumer allocates
+// against a single resource provider.
+type Allocation struct {
+	Resources map[string]int `json:"resources"`
+}
+
+// UpdateOpts represents options used to replace the allocations of a
+// consumer. It uses the dictionary format of the request body introduced in
+// microversion 1.12.
+type UpdateOpts struct {
+	// Allocations are the new allocations of the consumer, keyed by resource
+	// provider uuid.
+	Allocations map[string]Allocation `json:"allocations" required:"true"`
+
+	// ProjectID is the project the consumer belongs to.
+	ProjectID string `json:"project_id" required:"true"`
+
+	// UserID is the user the consumer belongs to.
+	UserID string `json:"user_id" required:"true"`
+
+	// ConsumerGeneration is the generation of the consumer seen by the
+	// caller. Leave it nil for a consumer without any allocations yet.
+	// Requires microversion 1.28 or later.
+	ConsumerGeneration *int `json:"consumer_generation,omitempty"`
+}
+
+// ToAllocationUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToAllocationUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update replaces the allocations of a consumer. With microversion 1.28 or
+// later a missing consumer generation is sent as null, which is how
+// Placement identifies a new consumer.
+func Update(client *gophercloud.ServiceClient, consumerID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToAllocationUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	if _, ok := b["consumer_generation"]; !ok && utils.MicroversionAtLeast(client.Microversion, "1.28") {
+		b["consumer_generation"] = nil
+	}
+	_, r.Err = client.Put(allocationsURL(client, consumerID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Delete removes all the allocations of a consumer.
+func Delete(client *gophercloud.ServiceClient, consumerID string) (r DeleteResult) {
+	_, r.Err = client.Delete(allocationsURL(client, consumerID), nil)
+	return
+}
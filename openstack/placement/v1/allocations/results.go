@@ -0,0 +1,59 @@
+package allocations
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ProviderAllocation is the amount of each resource class a consumer has
+// allocated against a single resource provider.
+type ProviderAllocation struct {
+	// Generation is the generation of the resource provider.
+	Generation int `json:"generation"`
+
+	// Resources are the allocated amounts, keyed by resource class.
+	Resources map[string]int `json:"resources"`
+}
+
+// ConsumerAllocations are all the allocations of a single consumer.
+type ConsumerAllocations struct {
+	// Allocations are the allocations of the consumer, keyed by resource
+	// provider uuid.
+	Allocations map[string]ProviderAllocation `json:"allocations"`
+
+	// ConsumerGeneration is the generation of the consumer.
+	// Requires microversion 1.28 or later.
+	ConsumerGeneration int `json:"consumer_generation"`
+
+	// ProjectID is the project the consumer belongs to.
+	// Requires microversion 1.12 or later.
+	ProjectID string `json:"project_id"`
+
+	// UserID is the user the consumer belongs to.
+	// Requires microversion 1.12 or later.
+	UserID string `json:"user_id"`
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as ConsumerAllocations.
+type GetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetResult as ConsumerAllocations.
+func (r GetResult) Extract() (*ConsumerAllocations, error) {
+	var s ConsumerAllocations
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// UpdateResult is the response from an Update operation. Call its
+// ExtractErr method to determine if the call succeeded or failed.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
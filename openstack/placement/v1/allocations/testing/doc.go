@@ -0,0 +1,2 @@
+// placement allocations
+package testing
@@ -0,0 +1,103 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/allocations"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+const ConsumerID = "30a4a27e-ee7c-4f1e-9da5-a4a17cf3ad80"
+
+const GetBody = `
+{
+  "allocations": {
+    "92637880-2d79-43c6-afab-d860886c6391": {
+      "generation": 2,
+      "resources": {
+        "DISK_GB": 5
+      }
+    },
+    "ba8e1ef8-7fa3-41a4-9bb4-d7cb2019899b": {
+      "generation": 8,
+      "resources": {
+        "MEMORY_MB": 512,
+        "VCPU": 2
+      }
+    }
+  },
+  "consumer_generation": 1,
+  "project_id": "7e67cbf7-7c38-4a32-b85b-0739c690991a",
+  "user_id": "067f691e-725a-451a-83e2-5c3d13e1dffc"
+}
+`
+
+const UpdateRequest = `
+{
+  "allocations": {
+    "4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+      "resources": {
+        "MEMORY_MB": 512,
+        "VCPU": 1
+      }
+    }
+  },
+  "consumer_generation": null,
+  "project_id": "7e67cbf7-7c38-4a32-b85b-0739c690991a",
+  "user_id": "067f691e-725a-451a-83e2-5c3d13e1dffc"
+}
+`
+
+var ExpectedAllocations = allocations.ConsumerAllocations{
+	Allocations: map[string]allocations.ProviderAllocation{
+		"92637880-2d79-43c6-afab-d860886c6391": {
+			Generation: 2,
+			Resources: map[string]int{
+				"DISK_GB": 5,
+			},
+		},
+		"ba8e1ef8-7fa3-41a4-9bb4-d7cb2019899b": {
+			Generation: 8,
+			Resources: map[string]int{
+				"MEMORY_MB": 512,
+				"VCPU":      2,
+			},
+		},
+	},
+	ConsumerGeneration: 1,
+	ProjectID:          "7e67cbf7-7c38-4a32-b85b-0739c690991a",
+	UserID:             "067f691e-725a-451a-83e2-5c3d13e1dffc",
+}
+
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/allocations/"+ConsumerID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, GetBody)
+	})
+}
+
+func HandleUpdateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/allocations/"+ConsumerID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, UpdateRequest)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/allocations/"+ConsumerID, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
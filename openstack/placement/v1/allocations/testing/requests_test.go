@@ -0,0 +1,56 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/allocations"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetSuccessfully(t)
+
+	actual, err := allocations.Get(client.ServiceClient(), ConsumerID).Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, &ExpectedAllocations, actual)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleUpdateSuccessfully(t)
+
+	updateOpts := allocations.UpdateOpts{
+		Allocations: map[string]allocations.Allocation{
+			"4e8e5957-649f-477b-9e5b-f1f75b21c03c": {
+				Resources: map[string]int{
+					"MEMORY_MB": 512,
+					"VCPU":      1,
+				},
+			},
+		},
+		ProjectID: "7e67cbf7-7c38-4a32-b85b-0739c690991a",
+		UserID:    "067f691e-725a-451a-83e2-5c3d13e1dffc",
+	}
+
+	c := client.ServiceClient()
+	c.Microversion = "1.28"
+
+	err := allocations.Update(c, ConsumerID, updateOpts).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDeleteSuccessfully(t)
+
+	err := allocations.Delete(client.ServiceClient(), ConsumerID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,7 @@
+package allocations
+
+import "github.com/chjlangzi/gophercloud"
+
+func allocationsURL(client *gophercloud.ServiceClient, consumerID string) string {
+	return client.ServiceURL("allocations", consumerID)
+}
@@ -0,0 +1,152 @@
+/*
+Package resourceproviders creates and lists all resource providers from the
+OpenStack Placement service, and manages their inventories, usages,
+allocations, traits and aggregates.
+
+The Placement API is versioned with microversions. Set the Microversion field
+of the client to use features that require a particular microversion.
+
+Example to List Resource Providers
+
+	listOpts := resourceproviders.ListOpts{
+		Resources: "VCPU:2,MEMORY_MB:2048",
+	}
+
+	allPages, err := resourceproviders.List(placementClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allResourceProviders, err := resourceproviders.ExtractResourceProviders(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, r := range allResourceProviders {
+		fmt.Printf("%+v\n", r)
+	}
+
+Example to Create a Resource Provider
+
+	createOpts := resourceproviders.CreateOpts{
+		Name: "new-rp",
+		UUID: "b99b3ab4-3aa6-4fba-b827-69b88b9c544a",
+	}
+
+	placementClient.Microversion = "1.20"
+	rp, err := resourceproviders.Create(placementClient, createOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Update a Resource Provider
+
+	updateOpts := resourceproviders.UpdateOpts{
+		Name: "renamed-rp",
+	}
+
+	rp, err := resourceproviders.Update(placementClient, rpID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Resource Provider
+
+	err := resourceproviders.Delete(placementClient, rpID).ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Inventories of a Resource Provider
+
+	inventories, err := resourceproviders.GetInventories(placementClient, rpID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	for class, inventory := range inventories.Inventories {
+		fmt.Printf("%s: %d\n", class, inventory.Total)
+	}
+
+Example to Replace the Inventories of a Resource Provider
+
+	updateOpts := resourceproviders.UpdateInventoriesOpts{
+		ResourceProviderGeneration: inventories.ResourceProviderGeneration,
+		Inventories: map[string]resourceproviders.Inventory{
+			"VCPU": {
+				Total:           32,
+				AllocationRatio: 4.0,
+				MaxUnit:         32,
+				MinUnit:         1,
+				StepSize:        1,
+			},
+		},
+	}
+
+	inventories, err = resourceproviders.UpdateInventories(placementClient, rpID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Usages of a Resource Provider
+
+	usages, err := resourceproviders.GetUsages(placementClient, rpID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Get the Free Capacity of a Resource Provider
+
+	capacity, err := resourceproviders.GetCapacity(placementClient, rpID)
+	if err != nil {
+		panic(err)
+	}
+
+	for class, c := range capacity {
+		fmt.Printf("%s: %d of %d free\n", class, c.Free, c.Capacity)
+	}
+
+Example to Get the Allocations Against a Resource Provider
+
+	allocations, err := resourceproviders.GetAllocations(placementClient, rpID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Replace the Traits of a Resource Provider
+
+	traits, err := resourceproviders.GetTraits(placementClient, rpID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	placementClient.Microversion = "1.6"
+	updateOpts := resourceproviders.UpdateTraitsOpts{
+		ResourceProviderGeneration: traits.ResourceProviderGeneration,
+		Traits:                     []string{"HW_CPU_X86_AVX2", "CUSTOM_GOLD"},
+	}
+
+	traits, err = resourceproviders.UpdateTraits(placementClient, rpID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Replace the Aggregates of a Resource Provider
+
+	placementClient.Microversion = "1.19"
+	aggregates, err := resourceproviders.GetAggregates(placementClient, rpID).Extract()
+	if err != nil {
+		panic(err)
+	}
+
+	updateOpts := resourceproviders.UpdateAggregatesOpts{
+		ResourceProviderGeneration: aggregates.ResourceProviderGeneration,
+		Aggregates:                 []string{"42896e0d-205d-4fe3-bd1e-100924931787"},
+	}
+
+	aggregates, err = resourceproviders.UpdateAggregates(placementClient, rpID, updateOpts).Extract()
+	if err != nil {
+		panic(err)
+	}
+*/
+package resourceproviders
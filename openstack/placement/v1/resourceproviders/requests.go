@@ -0,0 +1,395 @@
+package resourceproviders
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/utils"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToResourceProviderListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of resource providers. Filtering is achieved
+// by passing in struct field values that map to the resource provider
+// attributes you want to see returned.
+type ListOpts struct {
+	// Name is the name of the resource provider to filter the list.
+	Name string `q:"name"`
+
+	// UUID is the uuid of the resource provider to filter the list.
+	UUID string `q:"uuid"`
+
+	// MemberOf is a string representing aggregate uuids to filter or exclude
+	// from the list, e.g. "in:<uuid1>,<uuid2>".
+	// Requires microversion 1.3 or later.
+	MemberOf string `q:"member_of"`
+
+	// Resources is a comma-separated list of resource class and amount pairs
+	// the resource providers must have capacity for, e.g. "VCPU:4,DISK_GB:64".
+	// Requires microversion 1.4 or later.
+	Resources string `q:"resources"`
+
+	// InTree filters the list to the resource providers in the same tree as
+	// the given resource provider. Requires microversion 1.14 or later.
+	InTree string `q:"in_tree"`
+
+	// Required is a comma-separated list of traits the resource providers must
+	// have, or must not have when prefixed with "!".
+	// Requires microversion 1.18 or later.
+	Required string `q:"required"`
+}
+
+// ToResourceProviderListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToResourceProviderListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List makes a request against the API to list resource providers.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := resourceProvidersListURL(client)
+
+	if opts != nil {
+		query, err := opts.ToResourceProviderListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return ResourceProvidersPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// CreateOptsBuilder allows extensions to add additional parameters to the
+// Create request.
+type CreateOptsBuilder interface {
+	ToResourceProviderCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts represents options used to create a resource provider.
+type CreateOpts struct {
+	// Name is the name of the resource provider.
+	Name string `json:"name" required:"true"`
+
+	// UUID is the uuid of the resource provider. One is generated by the
+	// server if omitted.
+	UUID string `json:"uuid,omitempty"`
+
+	// ParentProviderUUID is the uuid of the parent provider of the resource
+	// provider. Requires microversion 1.14 or later.
+	ParentProviderUUID string `json:"parent_provider_uuid,omitempty"`
+}
+
+// ToResourceProviderCreateMap constructs a request body from CreateOpts.
+func (opts CreateOpts) ToResourceProviderCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Create makes a request against the API to create a resource provider.
+// The created resource provider is only returned in the response body with
+// microversion 1.20 or later. Before 1.20 the response has no body, so
+// Extract returns an empty ResourceProvider and the URL of the new resource
+// provider is available from the Location header of the result.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToResourceProviderCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	var body interface{}
+	if utils.MicroversionAtLeast(client.Microversion, "1.20") {
+		body = &r.Body
+	}
+
+	resp, err := client.Post(resourceProvidersListURL(client), b, body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	r.Err = err
+	return
+}
+
+// Get retrieves a specific resource provider based on its unique ID.
+func Get(client *gophercloud.ServiceClient, resourceProviderID string) (r GetResult) {
+	_, r.Err = client.Get(resourceProviderURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
+
+// UpdateOptsBuilder allows extensions to add additional parameters to the
+// Update request.
+type UpdateOptsBuilder interface {
+	ToResourceProviderUpdateMap() (map[string]interface{}, error)
+}
+
+// UpdateOpts represents options used to update a resource provider.
+type UpdateOpts struct {
+	// Name is the new name of the resource provider.
+	Name string `json:"name" required:"true"`
+
+	// ParentProviderUUID is the uuid of the new parent provider of the
+	// resource provider. Requires microversion 1.14 or later.
+	ParentProviderUUID string `json:"parent_provider_uuid,omitempty"`
+}
+
+// ToResourceProviderUpdateMap constructs a request body from UpdateOpts.
+func (opts UpdateOpts) ToResourceProviderUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// Update makes a request against the API to update a resource provider.
+func Update(client *gophercloud.ServiceClient, resourceProviderID string, opts UpdateOptsBuilder) (r UpdateResult) {
+	b, err := opts.ToResourceProviderUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(resourceProviderURL(client, resourceProviderID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// Delete accepts a unique ID and deletes the resource provider associated
+// with it.
+func Delete(client *gophercloud.ServiceClient, resourceProviderID string) (r DeleteResult) {
+	_, r.Err = client.Delete(resourceProviderURL(client, resourceProviderID), nil)
+	return
+}
+
+// GetInventories retrieves all the inventories of a resource provider.
+func GetInventories(client *gophercloud.ServiceClient, resourceProviderID string) (r GetInventoriesResult) {
+	_, r.Err = client.Get(inventoriesURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
+
+// UpdateInventoriesOptsBuilder allows extensions to add additional parameters
+// to the UpdateInventories request.
+type UpdateInventoriesOptsBuilder interface {
+	ToResourceProviderUpdateInventoriesMap() (map[string]interface{}, error)
+}
+
+// UpdateInventoriesOpts represents options used to replace all the
+// inventories of a resource provider.
+type UpdateInventoriesOpts struct {
+	// ResourceProviderGeneration is the generation of the resource provider
+	// seen by the caller. The update fails with a conflict if the resource
+	// provider has been changed in the meantime.
+	ResourceProviderGeneration int `json:"resource_provider_generation"`
+
+	// Inventories are the new inventories, keyed by resource class.
+	Inventories map[string]Inventory `json:"inventories" required:"true"`
+}
+
+// ToResourceProviderUpdateInventoriesMap constructs a request body from
+// UpdateInventoriesOpts.
+func (opts UpdateInventoriesOpts) ToResourceProviderUpdateInventoriesMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// UpdateInventories replaces all the inventories of a resource provider.
+func UpdateInventories(client *gophercloud.ServiceClient, resourceProviderID string, opts UpdateInventoriesOptsBuilder) (r UpdateInventoriesResult) {
+	b, err := opts.ToResourceProviderUpdateInventoriesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(inventoriesURL(client, resourceProviderID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteInventories deletes all the inventories of a resource provider.
+// Requires microversion 1.5 or later.
+func DeleteInventories(client *gophercloud.ServiceClient, resourceProviderID string) (r DeleteResult) {
+	_, r.Err = client.Delete(inventoriesURL(client, resourceProviderID), nil)
+	return
+}
+
+// GetInventory retrieves the inventory of a single resource class of a
+// resource provider.
+func GetInventory(client *gophercloud.ServiceClient, resourceProviderID, resourceClass string) (r GetInventoryResult) {
+	_, r.Err = client.Get(inventoryURL(client, resourceProviderID, resourceClass), &r.Body, nil)
+	return
+}
+
+// UpdateInventoryOptsBuilder allows extensions to add additional parameters
+// to the UpdateInventory request.
+type UpdateInventoryOptsBuilder interface {
+	ToResourceProviderUpdateInventoryMap() (map[string]interface{}, error)
+}
+
+// UpdateInventoryOpts represents options used to create or replace the
+// inventory of a single resource class of a resource provider.
+type UpdateInventoryOpts struct {
+	// ResourceProviderGeneration is the generation of the resource provider
+	// seen by the caller.
+	ResourceProviderGeneration int `json:"resource_provider_generation"`
+
+	// Total is the actual amount of the resource that the provider can
+	// accommodate.
+	Total int `json:"total" required:"true"`
+
+	// AllocationRatio is the overcommit ratio of the resource.
+	AllocationRatio float32 `json:"allocation_ratio,omitempty"`
+
+	// MaxUnit is the maximum amount of the resource a single allocation can
+	// request.
+	MaxUnit int `json:"max_unit,omitempty"`
+
+	// MinUnit is the minimum amount of the resource a single allocation can
+	// request.
+	MinUnit int `json:"min_unit,omitempty"`
+
+	// Reserved is the amount of the resource reserved for the host.
+	Reserved int `json:"reserved"`
+
+	// StepSize is the granularity of the amounts a single allocation can
+	// request.
+	StepSize int `json:"step_size,omitempty"`
+}
+
+// ToResourceProviderUpdateInventoryMap constructs a request body from
+// UpdateInventoryOpts.
+func (opts UpdateInventoryOpts) ToResourceProviderUpdateInventoryMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// UpdateInventory creates or replaces the inventory of a single resource
+// class of a resource provider.
+func UpdateInventory(client *gophercloud.ServiceClient, resourceProviderID, resourceClass string, opts UpdateInventoryOptsBuilder) (r UpdateInventoryResult) {
+	b, err := opts.ToResourceProviderUpdateInventoryMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(inventoryURL(client, resourceProviderID, resourceClass), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteInventory deletes the inventory of a single resource class of a
+// resource provider.
+func DeleteInventory(client *gophercloud.ServiceClient, resourceProviderID, resourceClass string) (r DeleteResult) {
+	_, r.Err = client.Delete(inventoryURL(client, resourceProviderID, resourceClass), nil)
+	return
+}
+
+// GetUsages retrieves the usages of a resource provider, keyed by resource
+// class.
+func GetUsages(client *gophercloud.ServiceClient, resourceProviderID string) (r GetUsagesResult) {
+	_, r.Err = client.Get(usagesURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
+
+// GetAllocations retrieves the allocations made against a resource provider,
+// keyed by consumer.
+func GetAllocations(client *gophercloud.ServiceClient, resourceProviderID string) (r GetAllocationsResult) {
+	_, r.Err = client.Get(allocationsURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
+
+// GetTraits retrieves the traits of a resource provider.
+// Requires microversion 1.6 or later.
+func GetTraits(client *gophercloud.ServiceClient, resourceProviderID string) (r GetTraitsResult) {
+	_, r.Err = client.Get(traitsURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
+
+// UpdateTraitsOptsBuilder allows extensions to add additional parameters to
+// the UpdateTraits request.
+type UpdateTraitsOptsBuilder interface {
+	ToResourceProviderUpdateTraitsMap() (map[string]interface{}, error)
+}
+
+// UpdateTraitsOpts represents options used to replace the traits of a
+// resource provider.
+type UpdateTraitsOpts struct {
+	// ResourceProviderGeneration is the generation of the resource provider
+	// seen by the caller.
+	ResourceProviderGeneration int `json:"resource_provider_generation"`
+
+	// Traits is the new list of traits of the resource provider.
+	Traits []string `json:"traits" required:"true"`
+}
+
+// ToResourceProviderUpdateTraitsMap constructs a request body from
+// UpdateTraitsOpts.
+func (opts UpdateTraitsOpts) ToResourceProviderUpdateTraitsMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// UpdateTraits replaces the traits of a resource provider.
+// Requires microversion 1.6 or later.
+func UpdateTraits(client *gophercloud.ServiceClient, resourceProviderID string, opts UpdateTraitsOptsBuilder) (r UpdateTraitsResult) {
+	b, err := opts.ToResourceProviderUpdateTraitsMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(traitsURL(client, resourceProviderID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// DeleteTraits removes all the traits of a resource provider.
+// Requires microversion 1.6 or later.
+func DeleteTraits(client *gophercloud.ServiceClient, resourceProviderID string) (r DeleteResult) {
+	_, r.Err = client.Delete(traitsURL(client, resourceProviderID), nil)
+	return
+}
+
+// GetAggregates retrieves the aggregates a resource provider is a member of.
+// Requires microversion 1.1 or later; the resource provider generation is
+// only returned with microversion 1.19 or later.
+func GetAggregates(client *gophercloud.ServiceClient, resourceProviderID string) (r GetAggregatesResult) {
+	_, r.Err = client.Get(aggregatesURL(client, resourceProviderID), &r.Body, nil)
+	return
+}
+
+// UpdateAggregatesOptsBuilder allows extensions to add additional parameters
+// to the UpdateAggregates request.
+type UpdateAggregatesOptsBuilder interface {
+	ToResourceProviderUpdateAggregatesMap() (map[string]interface{}, error)
+}
+
+// UpdateAggregatesOpts represents options used to replace the aggregates a
+// resource provider is a member of.
+type UpdateAggregatesOpts struct {
+	// ResourceProviderGeneration is the generation of the resource provider
+	// seen by the caller.
+	ResourceProviderGeneration int `json:"resource_provider_generation"`
+
+	// Aggregates is the new list of aggregate uuids of the resource provider.
+	Aggregates []string `json:"aggregates" required:"true"`
+}
+
+// ToResourceProviderUpdateAggregatesMap constructs a request body from
+// UpdateAggregatesOpts.
+func (opts UpdateAggregatesOpts) ToResourceProviderUpdateAggregatesMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// UpdateAggregates replaces the aggregates a resource provider is a member
+// of. Requires microversion 1.19 or later.
+func UpdateAggregates(client *gophercloud.ServiceClient, resourceProviderID string, opts UpdateAggregatesOptsBuilder) (r UpdateAggregatesResult) {
+	b, err := opts.ToResourceProviderUpdateAggregatesMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(aggregatesURL(client, resourceProviderID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
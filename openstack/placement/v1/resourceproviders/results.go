@@ -0,0 +1,286 @@
+package resourceproviders
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// ResourceProviderLinks represents links associated with a resource provider.
+type ResourceProviderLinks struct {
+	Href string `json:"href"`
+	Rel  string `json:"rel"`
+}
+
+// ResourceProvider are entities which provider consumable inventory of one or
+// more classes of resource.
+type ResourceProvider struct {
+	// Generation is a consistent view marker that assists with the management
+	// of concurrent resource provider updates.
+	Generation int `json:"generation"`
+
+	// UUID of a resource provider.
+	UUID string `json:"uuid"`
+
+	// Links is a list of links associated with one resource provider.
+	Links []ResourceProviderLinks `json:"links"`
+
+	// Name of one resource provider.
+	Name string `json:"name"`
+
+	// The ParentProviderUUID contains the UUID of the immediate parent of the
+	// resource provider. Requires microversion 1.14 or later.
+	ParentProviderUUID string `json:"parent_provider_uuid"`
+
+	// The RootProviderUUID contains the UUID of the top-most provider in this
+	// provider tree. Requires microversion 1.14 or later.
+	RootProviderUUID string `json:"root_provider_uuid"`
+}
+
+// Inventory is the amount of a resource class a resource provider can
+// accommodate, along with the constraints on how it may be allocated.
+type Inventory struct {
+	// AllocationRatio is the overcommit ratio of the resource.
+	AllocationRatio float32 `json:"allocation_ratio,omitempty"`
+
+	// MaxUnit is the maximum amount of the resource a single allocation can
+	// request.
+	MaxUnit int `json:"max_unit,omitempty"`
+
+	// MinUnit is the minimum amount of the resource a single allocation can
+	// request.
+	MinUnit int `json:"min_unit,omitempty"`
+
+	// Reserved is the amount of the resource reserved for the host.
+	Reserved int `json:"reserved"`
+
+	// StepSize is the granularity of the amounts a single allocation can
+	// request.
+	StepSize int `json:"step_size,omitempty"`
+
+	// Total is the actual amount of the resource that the provider can
+	// accommodate.
+	Total int `json:"total"`
+}
+
+// ResourceProviderInventories are the inventories of a resource provider,
+// keyed by resource class.
+type ResourceProviderInventories struct {
+	ResourceProviderGeneration int                  `json:"resource_provider_generation"`
+	Inventories                map[string]Inventory `json:"inventories"`
+}
+
+// ResourceProviderInventory is the inventory of a single resource class of a
+// resource provider.
+type ResourceProviderInventory struct {
+	ResourceProviderGeneration int `json:"resource_provider_generation"`
+	Inventory
+}
+
+// ResourceProviderUsage are the usages of a resource provider, keyed by
+// resource class.
+type ResourceProviderUsage struct {
+	ResourceProviderGeneration int            `json:"resource_provider_generation"`
+	Usages                     map[string]int `json:"usages"`
+}
+
+// Allocation is the amount of resources a single consumer has allocated
+// against a resource provider, keyed by resource class.
+type Allocation struct {
+	Resources map[string]int `json:"resources"`
+}
+
+// ResourceProviderAllocations are the allocations made against a resource
+// provider, keyed by consumer uuid.
+type ResourceProviderAllocations struct {
+	ResourceProviderGeneration int                   `json:"resource_provider_generation"`
+	Allocations                map[string]Allocation `json:"allocations"`
+}
+
+// ResourceProviderTraits are the traits of a resource provider.
+type ResourceProviderTraits struct {
+	ResourceProviderGeneration int      `json:"resource_provider_generation"`
+	Traits                     []string `json:"traits"`
+}
+
+// ResourceProviderAggregates are the aggregates a resource provider is a
+// member of.
+type ResourceProviderAggregates struct {
+	ResourceProviderGeneration int      `json:"resource_provider_generation"`
+	Aggregates                 []string `json:"aggregates"`
+}
+
+type resourceProviderResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a resourceProviderResult as a ResourceProvider.
+func (r resourceProviderResult) Extract() (*ResourceProvider, error) {
+	var s ResourceProvider
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// CreateResult is the response from a Create operation. Call its Extract
+// method to interpret it as a ResourceProvider.
+type CreateResult struct {
+	resourceProviderResult
+}
+
+// GetResult is the response from a Get operation. Call its Extract method
+// to interpret it as a ResourceProvider.
+type GetResult struct {
+	resourceProviderResult
+}
+
+// UpdateResult is the response from an Update operation. Call its Extract
+// method to interpret it as a ResourceProvider.
+type UpdateResult struct {
+	resourceProviderResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ResourceProvidersPage contains a single page of all resource providers
+// from a List call.
+type ResourceProvidersPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if a ResourceProvidersPage contains any results.
+func (page ResourceProvidersPage) IsEmpty() (bool, error) {
+	resourceProviders, err := ExtractResourceProviders(page)
+	return len(resourceProviders) == 0, err
+}
+
+// ExtractResourceProviders returns a slice of ResourceProviders contained in
+// a single page of results.
+func ExtractResourceProviders(r pagination.Page) ([]ResourceProvider, error) {
+	var s struct {
+		ResourceProviders []ResourceProvider `json:"resource_providers"`
+	}
+	err := (r.(ResourceProvidersPage)).ExtractInto(&s)
+	return s.ResourceProviders, err
+}
+
+type inventoriesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an inventoriesResult as ResourceProviderInventories.
+func (r inventoriesResult) Extract() (*ResourceProviderInventories, error) {
+	var s ResourceProviderInventories
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetInventoriesResult is the response from a GetInventories operation. Call
+// its Extract method to interpret it as ResourceProviderInventories.
+type GetInventoriesResult struct {
+	inventoriesResult
+}
+
+// UpdateInventoriesResult is the response from an UpdateInventories
+// operation. Call its Extract method to interpret it as
+// ResourceProviderInventories.
+type UpdateInventoriesResult struct {
+	inventoriesResult
+}
+
+type inventoryResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an inventoryResult as a ResourceProviderInventory.
+func (r inventoryResult) Extract() (*ResourceProviderInventory, error) {
+	var s ResourceProviderInventory
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetInventoryResult is the response from a GetInventory operation. Call its
+// Extract method to interpret it as a ResourceProviderInventory.
+type GetInventoryResult struct {
+	inventoryResult
+}
+
+// UpdateInventoryResult is the response from an UpdateInventory operation.
+// Call its Extract method to interpret it as a ResourceProviderInventory.
+type UpdateInventoryResult struct {
+	inventoryResult
+}
+
+// GetUsagesResult is the response from a GetUsages operation. Call its
+// Extract method to interpret it as a ResourceProviderUsage.
+type GetUsagesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetUsagesResult as a ResourceProviderUsage.
+func (r GetUsagesResult) Extract() (*ResourceProviderUsage, error) {
+	var s ResourceProviderUsage
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetAllocationsResult is the response from a GetAllocations operation. Call
+// its Extract method to interpret it as ResourceProviderAllocations.
+type GetAllocationsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetAllocationsResult as ResourceProviderAllocations.
+func (r GetAllocationsResult) Extract() (*ResourceProviderAllocations, error) {
+	var s ResourceProviderAllocations
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+type traitsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a traitsResult as ResourceProviderTraits.
+func (r traitsResult) Extract() (*ResourceProviderTraits, error) {
+	var s ResourceProviderTraits
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetTraitsResult is the response from a GetTraits operation. Call its
+// Extract method to interpret it as ResourceProviderTraits.
+type GetTraitsResult struct {
+	traitsResult
+}
+
+// UpdateTraitsResult is the response from an UpdateTraits operation. Call
+// its Extract method to interpret it as ResourceProviderTraits.
+type UpdateTraitsResult struct {
+	traitsResult
+}
+
+type aggregatesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an aggregatesResult as ResourceProviderAggregates.
+func (r aggregatesResult) Extract() (*ResourceProviderAggregates, error) {
+	var s ResourceProviderAggregates
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+// GetAggregatesResult is the response from a GetAggregates operation. Call
+// its Extract method to interpret it as ResourceProviderAggregates.
+type GetAggregatesResult struct {
+	aggregatesResult
+}
+
+// UpdateAggregatesResult is the response from an UpdateAggregates operation.
+// Call its Extract method to interpret it as ResourceProviderAggregates.
+type UpdateAggregatesResult struct {
+	aggregatesResult
+}
@@ -0,0 +1,2 @@
+// placement resource providers
+package testing
@@ -0,0 +1,458 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/resourceproviders"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+const ResourceProviderTestID = "99c09379-6e52-4ef8-9a95-b9ce6f68452e"
+
+const ResourceProvidersBody = `
+{
+  "resource_providers": [
+    {
+      "generation": 1,
+      "uuid": "99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+      "links": [
+        {
+          "href": "/resource_providers/99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+          "rel": "self"
+        }
+      ],
+      "name": "vgr.localdomain",
+      "parent_provider_uuid": "542df8ed-9be2-49b9-b4db-6d3183ff8ec8",
+      "root_provider_uuid": "542df8ed-9be2-49b9-b4db-6d3183ff8ec8"
+    },
+    {
+      "generation": 2,
+      "uuid": "d0b381e9-8761-42de-8e6c-bba99a96d5f5",
+      "links": [
+        {
+          "href": "/resource_providers/d0b381e9-8761-42de-8e6c-bba99a96d5f5",
+          "rel": "self"
+        }
+      ],
+      "name": "pony1",
+      "parent_provider_uuid": null,
+      "root_provider_uuid": "d0b381e9-8761-42de-8e6c-bba99a96d5f5"
+    }
+  ]
+}
+`
+
+const ResourceProviderBody = `
+{
+  "generation": 1,
+  "uuid": "99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+  "links": [
+    {
+      "href": "/resource_providers/99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+      "rel": "self"
+    }
+  ],
+  "name": "vgr.localdomain",
+  "parent_provider_uuid": "542df8ed-9be2-49b9-b4db-6d3183ff8ec8",
+  "root_provider_uuid": "542df8ed-9be2-49b9-b4db-6d3183ff8ec8"
+}
+`
+
+const InventoriesBody = `
+{
+  "inventories": {
+    "DISK_GB": {
+      "allocation_ratio": 1.0,
+      "max_unit": 35,
+      "min_unit": 1,
+      "reserved": 0,
+      "step_size": 1,
+      "total": 35
+    },
+    "MEMORY_MB": {
+      "allocation_ratio": 1.5,
+      "max_unit": 5825,
+      "min_unit": 1,
+      "reserved": 512,
+      "step_size": 1,
+      "total": 5825
+    },
+    "VCPU": {
+      "allocation_ratio": 16.0,
+      "max_unit": 4,
+      "min_unit": 1,
+      "reserved": 0,
+      "step_size": 1,
+      "total": 4
+    }
+  },
+  "resource_provider_generation": 7
+}
+`
+
+const InventoryBody = `
+{
+  "allocation_ratio": 16.0,
+  "max_unit": 4,
+  "min_unit": 1,
+  "reserved": 0,
+  "resource_provider_generation": 9,
+  "step_size": 1,
+  "total": 8
+}
+`
+
+const UsagesBody = `
+{
+  "resource_provider_generation": 1,
+  "usages": {
+    "DISK_GB": 1,
+    "MEMORY_MB": 512,
+    "VCPU": 1
+  }
+}
+`
+
+const AllocationsBody = `
+{
+  "allocations": {
+    "56785a3f-6f1c-4fec-af0b-0faf075b1fcb": {
+      "resources": {
+        "MEMORY_MB": 256,
+        "VCPU": 1
+      }
+    },
+    "9afd5aeb-d6b9-4dea-a588-1e6327a91834": {
+      "resources": {
+        "MEMORY_MB": 512,
+        "VCPU": 2
+      }
+    }
+  },
+  "resource_provider_generation": 12
+}
+`
+
+const TraitsBody = `
+{
+  "resource_provider_generation": 1,
+  "traits": [
+    "CUSTOM_HW_FPGA_CLASS1",
+    "CUSTOM_HW_FPGA_CLASS3"
+  ]
+}
+`
+
+const AggregatesBody = `
+{
+  "aggregates": [
+    "42896e0d-205d-4fe3-bd1e-100924931787",
+    "5e08ea53-c4c6-448e-9334-ac4953de3cfa"
+  ],
+  "resource_provider_generation": 8
+}
+`
+
+var ExpectedResourceProvider1 = resourceproviders.ResourceProvider{
+	Generation: 1,
+	UUID:       "99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+	Links: []resourceproviders.ResourceProviderLinks{
+		{
+			Href: "/resource_providers/99c09379-6e52-4ef8-9a95-b9ce6f68452e",
+			Rel:  "self",
+		},
+	},
+	Name:               "vgr.localdomain",
+	ParentProviderUUID: "542df8ed-9be2-49b9-b4db-6d3183ff8ec8",
+	RootProviderUUID:   "542df8ed-9be2-49b9-b4db-6d3183ff8ec8",
+}
+
+var ExpectedResourceProvider2 = resourceproviders.ResourceProvider{
+	Generation: 2,
+	UUID:       "d0b381e9-8761-42de-8e6c-bba99a96d5f5",
+	Links: []resourceproviders.ResourceProviderLinks{
+		{
+			Href: "/resource_providers/d0b381e9-8761-42de-8e6c-bba99a96d5f5",
+			Rel:  "self",
+		},
+	},
+	Name:               "pony1",
+	ParentProviderUUID: "",
+	RootProviderUUID:   "d0b381e9-8761-42de-8e6c-bba99a96d5f5",
+}
+
+var ExpectedResourceProviders = []resourceproviders.ResourceProvider{
+	ExpectedResourceProvider1,
+	ExpectedResourceProvider2,
+}
+
+var ExpectedInventories = resourceproviders.ResourceProviderInventories{
+	ResourceProviderGeneration: 7,
+	Inventories: map[string]resourceproviders.Inventory{
+		"DISK_GB": {
+			AllocationRatio: 1.0,
+			MaxUnit:         35,
+			MinUnit:         1,
+			Reserved:        0,
+			StepSize:        1,
+			Total:           35,
+		},
+		"MEMORY_MB": {
+			AllocationRatio: 1.5,
+			MaxUnit:         5825,
+			MinUnit:         1,
+			Reserved:        512,
+			StepSize:        1,
+			Total:           5825,
+		},
+		"VCPU": {
+			AllocationRatio: 16.0,
+			MaxUnit:         4,
+			MinUnit:         1,
+			Reserved:        0,
+			StepSize:        1,
+			Total:           4,
+		},
+	},
+}
+
+var ExpectedInventory = resourceproviders.ResourceProviderInventory{
+	ResourceProviderGeneration: 9,
+	Inventory: resourceproviders.Inventory{
+		AllocationRatio: 16.0,
+		MaxUnit:         4,
+		MinUnit:         1,
+		Reserved:        0,
+		StepSize:        1,
+		Total:           8,
+	},
+}
+
+var ExpectedUsages = resourceproviders.ResourceProviderUsage{
+	ResourceProviderGeneration: 1,
+	Usages: map[string]int{
+		"DISK_GB":   1,
+		"MEMORY_MB": 512,
+		"VCPU":      1,
+	},
+}
+
+var ExpectedAllocations = resourceproviders.ResourceProviderAllocations{
+	ResourceProviderGeneration: 12,
+	Allocations: map[string]resourceproviders.Allocation{
+		"56785a3f-6f1c-4fec-af0b-0faf075b1fcb": {
+			Resources: map[string]int{
+				"MEMORY_MB": 256,
+				"VCPU":      1,
+			},
+		},
+		"9afd5aeb-d6b9-4dea-a588-1e6327a91834": {
+			Resources: map[string]int{
+				"MEMORY_MB": 512,
+				"VCPU":      2,
+			},
+		},
+	},
+}
+
+var ExpectedTraits = resourceproviders.ResourceProviderTraits{
+	ResourceProviderGeneration: 1,
+	Traits: []string{
+		"CUSTOM_HW_FPGA_CLASS1",
+		"CUSTOM_HW_FPGA_CLASS3",
+	},
+}
+
+var ExpectedAggregates = resourceproviders.ResourceProviderAggregates{
+	ResourceProviderGeneration: 8,
+	Aggregates: []string{
+		"42896e0d-205d-4fe3-bd1e-100924931787",
+		"5e08ea53-c4c6-448e-9334-ac4953de3cfa",
+	},
+}
+
+var ExpectedCapacity = map[string]resourceproviders.ResourceClassCapacity{
+	"DISK_GB": {
+		Capacity: 35,
+		Used:     1,
+		Free:     34,
+		MaxUnit:  35,
+	},
+	"MEMORY_MB": {
+		Capacity: 7969,
+		Used:     512,
+		Free:     7457,
+		MaxUnit:  5825,
+	},
+	"VCPU": {
+		Capacity: 64,
+		Used:     1,
+		Free:     63,
+		MaxUnit:  4,
+	},
+}
+
+func handle(t *testing.T, path, method, requestBody string, status int, responseBody string) {
+	th.Mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, method)
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		if requestBody != "" {
+			th.TestJSONRequest(t, r, requestBody)
+		}
+
+		if responseBody == "" {
+			w.WriteHeader(status)
+			return
+		}
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, responseBody)
+	})
+}
+
+func HandleResourceProviderList(t *testing.T) {
+	th.Mux.HandleFunc("/resource_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"resources": "VCPU:2,MEMORY_MB:1024",
+			"required":  "HW_CPU_X86_AVX2",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ResourceProvidersBody)
+	})
+}
+
+func HandleResourceProviderCreate(t *testing.T) {
+	handle(t, "/resource_providers", "POST", `{
+		"name": "vgr.localdomain",
+		"parent_provider_uuid": "542df8ed-9be2-49b9-b4db-6d3183ff8ec8"
+	}`, http.StatusOK, ResourceProviderBody)
+}
+
+// HandleResourceProviderCreateWithoutBody mimics microversions older than
+// 1.20, which answer a create with 201 and an empty body.
+func HandleResourceProviderCreateWithoutBody(t *testing.T) {
+	th.Mux.HandleFunc("/resource_providers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestJSONRequest(t, r, `{"name": "vgr.localdomain"}`)
+
+		w.Header().Add("Location", "/resource_providers/"+ResourceProviderTestID)
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+func HandleResourceProviderGet(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID, "GET", "", http.StatusOK, ResourceProviderBody)
+}
+
+func HandleResourceProviderUpdate(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID, "PUT", `{
+		"name": "vgr.localdomain"
+	}`, http.StatusOK, ResourceProviderBody)
+}
+
+func HandleResourceProviderDelete(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID, "DELETE", "", http.StatusNoContent, "")
+}
+
+func HandleResourceProviderGetInventories(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/inventories", "GET", "", http.StatusOK, InventoriesBody)
+}
+
+func HandleResourceProviderUpdateInventories(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/inventories", "PUT", `{
+		"resource_provider_generation": 6,
+		"inventories": {
+			"DISK_GB": {
+				"allocation_ratio": 1.0,
+				"max_unit": 35,
+				"min_unit": 1,
+				"reserved": 0,
+				"step_size": 1,
+				"total": 35
+			},
+			"MEMORY_MB": {
+				"allocation_ratio": 1.5,
+				"max_unit": 5825,
+				"min_unit": 1,
+				"reserved": 512,
+				"step_size": 1,
+				"total": 5825
+			},
+			"VCPU": {
+				"allocation_ratio": 16.0,
+				"max_unit": 4,
+				"min_unit": 1,
+				"reserved": 0,
+				"step_size": 1,
+				"total": 4
+			}
+		}
+	}`, http.StatusOK, InventoriesBody)
+}
+
+func HandleResourceProviderGetInventory(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/inventories/VCPU", "GET", "", http.StatusOK, InventoryBody)
+}
+
+func HandleResourceProviderUpdateInventory(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/inventories/VCPU", "PUT", `{
+		"resource_provider_generation": 8,
+		"allocation_ratio": 16.0,
+		"max_unit": 4,
+		"reserved": 0,
+		"total": 8
+	}`, http.StatusOK, InventoryBody)
+}
+
+func HandleResourceProviderDeleteInventory(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/inventories/VCPU", "DELETE", "", http.StatusNoContent, "")
+}
+
+func HandleResourceProviderGetUsages(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/usages", "GET", "", http.StatusOK, UsagesBody)
+}
+
+func HandleResourceProviderGetAllocations(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/allocations", "GET", "", http.StatusOK, AllocationsBody)
+}
+
+func HandleResourceProviderGetTraits(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/traits", "GET", "", http.StatusOK, TraitsBody)
+}
+
+func HandleResourceProviderUpdateTraits(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/traits", "PUT", `{
+		"resource_provider_generation": 0,
+		"traits": [
+			"CUSTOM_HW_FPGA_CLASS1",
+			"CUSTOM_HW_FPGA_CLASS3"
+		]
+	}`, http.StatusOK, TraitsBody)
+}
+
+func HandleResourceProviderDeleteTraits(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/traits", "DELETE", "", http.StatusNoContent, "")
+}
+
+func HandleResourceProviderGetAggregates(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/aggregates", "GET", "", http.StatusOK, AggregatesBody)
+}
+
+func HandleResourceProviderUpdateAggregates(t *testing.T) {
+	handle(t, "/resource_providers/"+ResourceProviderTestID+"/aggregates", "PUT", `{
+		"resource_provider_generation": 7,
+		"aggregates": [
+			"42896e0d-205d-4fe3-bd1e-100924931787",
+			"5e08ea53-c4c6-448e-9334-ac4953de3cfa"
+		]
+	}`, http.StatusOK, AggregatesBody)
+}
@@ -0,0 +1,299 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/resourceproviders"
+	"github.com/chjlangzi/gophercloud/pagination"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+func TestListResourceProviders(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderList(t)
+
+	listOpts := resourceproviders.ListOpts{
+		Resources: "VCPU:2,MEMORY_MB:1024",
+		Required:  "HW_CPU_X86_AVX2",
+	}
+
+	count := 0
+	err := resourceproviders.List(client.ServiceClient(), listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		count++
+
+		actual, err := resourceproviders.ExtractResourceProviders(page)
+		if err != nil {
+			t.Errorf("Failed to extract resource providers: %v", err)
+			return false, err
+		}
+		th.AssertDeepEquals(t, ExpectedResourceProviders, actual)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if count != 1 {
+		t.Errorf("Expected 1 page, got %d", count)
+	}
+}
+
+func TestCreateResourceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderCreate(t)
+
+	opts := resourceproviders.CreateOpts{
+		Name:               ExpectedResourceProvider1.Name,
+		ParentProviderUUID: ExpectedResourceProvider1.ParentProviderUUID,
+	}
+
+	sc := client.ServiceClient()
+	sc.Microversion = "1.20"
+
+	actual, err := resourceproviders.Create(sc, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedResourceProvider1, actual)
+}
+
+func TestCreateResourceProviderWithoutBody(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderCreateWithoutBody(t)
+
+	opts := resourceproviders.CreateOpts{
+		Name: ExpectedResourceProvider1.Name,
+	}
+
+	res := resourceproviders.Create(client.ServiceClient(), opts)
+	th.AssertNoErr(t, res.Err)
+	th.AssertEquals(t, "/resource_providers/"+ResourceProviderTestID, res.Header.Get("Location"))
+
+	actual, err := res.Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &resourceproviders.ResourceProvider{}, actual)
+}
+
+func TestGetResourceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGet(t)
+
+	actual, err := resourceproviders.Get(client.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedResourceProvider1, actual)
+}
+
+func TestUpdateResourceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderUpdate(t)
+
+	opts := resourceproviders.UpdateOpts{
+		Name: ExpectedResourceProvider1.Name,
+	}
+
+	actual, err := resourceproviders.Update(client.ServiceClient(), ResourceProviderTestID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedResourceProvider1, actual)
+}
+
+func TestDeleteResourceProvider(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderDelete(t)
+
+	err := resourceproviders.Delete(client.ServiceClient(), ResourceProviderTestID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetResourceProvidersInventories(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetInventories(t)
+
+	actual, err := resourceproviders.GetInventories(client.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedInventories, actual)
+}
+
+func TestUpdateResourceProvidersInventories(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderUpdateInventories(t)
+
+	opts := resourceproviders.UpdateInventoriesOpts{
+		ResourceProviderGeneration: 6,
+		Inventories:                ExpectedInventories.Inventories,
+	}
+
+	actual, err := resourceproviders.UpdateInventories(client.ServiceClient(), ResourceProviderTestID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedInventories, actual)
+}
+
+func TestGetResourceProvidersInventory(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetInventory(t)
+
+	actual, err := resourceproviders.GetInventory(client.ServiceClient(), ResourceProviderTestID, "VCPU").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedInventory, actual)
+}
+
+func TestUpdateResourceProvidersInventory(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderUpdateInventory(t)
+
+	opts := resourceproviders.UpdateInventoryOpts{
+		ResourceProviderGeneration: 8,
+		AllocationRatio:            16.0,
+		MaxUnit:                    4,
+		Total:                      8,
+	}
+
+	actual, err := resourceproviders.UpdateInventory(client.ServiceClient(), ResourceProviderTestID, "VCPU", opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedInventory, actual)
+}
+
+func TestDeleteResourceProvidersInventory(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderDeleteInventory(t)
+
+	err := resourceproviders.DeleteInventory(client.ServiceClient(), ResourceProviderTestID, "VCPU").ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetResourceProvidersUsages(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetUsages(t)
+
+	actual, err := resourceproviders.GetUsages(client.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedUsages, actual)
+}
+
+func TestGetResourceProvidersAllocations(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetAllocations(t)
+
+	actual, err := resourceproviders.GetAllocations(client.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedAllocations, actual)
+}
+
+func TestGetResourceProvidersTraits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetTraits(t)
+
+	actual, err := resourceproviders.GetTraits(client.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedTraits, actual)
+}
+
+func TestUpdateResourceProvidersTraits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderUpdateTraits(t)
+
+	opts := resourceproviders.UpdateTraitsOpts{
+		ResourceProviderGeneration: 0,
+		Traits:                     ExpectedTraits.Traits,
+	}
+
+	actual, err := resourceproviders.UpdateTraits(client.ServiceClient(), ResourceProviderTestID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedTraits, actual)
+}
+
+func TestDeleteResourceProvidersTraits(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderDeleteTraits(t)
+
+	err := resourceproviders.DeleteTraits(client.ServiceClient(), ResourceProviderTestID).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestGetResourceProvidersAggregates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetAggregates(t)
+
+	actual, err := resourceproviders.GetAggregates(client.ServiceClient(), ResourceProviderTestID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedAggregates, actual)
+}
+
+func TestUpdateResourceProvidersAggregates(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderUpdateAggregates(t)
+
+	opts := resourceproviders.UpdateAggregatesOpts{
+		ResourceProviderGeneration: 7,
+		Aggregates:                 ExpectedAggregates.Aggregates,
+	}
+
+	actual, err := resourceproviders.UpdateAggregates(client.ServiceClient(), ResourceProviderTestID, opts).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, &ExpectedAggregates, actual)
+}
+
+func TestGetResourceProviderCapacity(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleResourceProviderGetInventories(t)
+	HandleResourceProviderGetUsages(t)
+
+	actual, err := resourceproviders.GetCapacity(client.ServiceClient(), ResourceProviderTestID)
+	th.AssertNoErr(t, err)
+	th.AssertDeepEquals(t, ExpectedCapacity, actual)
+}
+
+func TestCapacityLargeTotals(t *testing.T) {
+	inventories := map[string]resourceproviders.Inventory{
+		"MEMORY_MB": {
+			AllocationRatio: 1.0,
+			MaxUnit:         16777217,
+			Reserved:        1,
+			Total:           16777218,
+		},
+	}
+
+	actual := resourceproviders.Capacity(inventories, map[string]int{"MEMORY_MB": 7})
+	th.AssertDeepEquals(t, resourceproviders.ResourceClassCapacity{
+		Capacity: 16777217,
+		Used:     7,
+		Free:     16777210,
+		MaxUnit:  16777217,
+	}, actual["MEMORY_MB"])
+}
@@ -0,0 +1,37 @@
+package resourceproviders
+
+import "github.com/chjlangzi/gophercloud"
+
+const resourceProvidersPath = "resource_providers"
+
+func resourceProvidersListURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL(resourceProvidersPath)
+}
+
+func resourceProviderURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(resourceProvidersPath, resourceProviderID)
+}
+
+func inventoriesURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(resourceProvidersPath, resourceProviderID, "inventories")
+}
+
+func inventoryURL(client *gophercloud.ServiceClient, resourceProviderID, resourceClass string) string {
+	return client.ServiceURL(resourceProvidersPath, resourceProviderID, "inventories", resourceClass)
+}
+
+func usagesURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(resourceProvidersPath, resourceProviderID, "usages")
+}
+
+func allocationsURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(resourceProvidersPath, resourceProviderID, "allocations")
+}
+
+func traitsURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(resourceProvidersPath, resourceProviderID, "traits")
+}
+
+func aggregatesURL(client *gophercloud.ServiceClient, resourceProviderID string) string {
+	return client.ServiceURL(resourceProvidersPath, resourceProviderID, "aggregates")
+}
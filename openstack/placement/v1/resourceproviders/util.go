@@ -0,0 +1,65 @@
+package resourceproviders
+
+import (
+	"github.com/chjlangzi/gophercloud"
+)
+
+// ResourceClassCapacity is the capacity of a single resource class of a
+// resource provider, taking its reserved amount and allocation ratio into
+// account.
+type ResourceClassCapacity struct {
+	// Capacity is the amount of the resource that can be allocated in total,
+	// i.e. (Total - Reserved) * AllocationRatio.
+	Capacity int
+
+	// Used is the amount of the resource currently allocated.
+	Used int
+
+	// Free is the amount of the resource that can still be allocated.
+	Free int
+
+	// MaxUnit is the maximum amount a single allocation can request.
+	MaxUnit int
+}
+
+// Capacity computes the capacity of every resource class of an inventory
+// given the usages of the resource provider.
+func Capacity(inventories map[string]Inventory, usages map[string]int) map[string]ResourceClassCapacity {
+	capacity := make(map[string]ResourceClassCapacity, len(inventories))
+	for class, inventory := range inventories {
+		ratio := float64(inventory.AllocationRatio)
+		if ratio == 0 {
+			ratio = 1.0
+		}
+
+		c := ResourceClassCapacity{
+			Capacity: int(float64(inventory.Total-inventory.Reserved) * ratio),
+			Used:     usages[class],
+			MaxUnit:  inventory.MaxUnit,
+		}
+		c.Free = c.Capacity - c.Used
+		if c.Free < 0 {
+			c.Free = 0
+		}
+
+		capacity[class] = c
+	}
+
+	return capacity
+}
+
+// GetCapacity retrieves the inventories and usages of a resource provider and
+// returns the capacity of each of its resource classes.
+func GetCapacity(client *gophercloud.ServiceClient, resourceProviderID string) (map[string]ResourceClassCapacity, error) {
+	inventories, err := GetInventories(client, resourceProviderID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := GetUsages(client, resourceProviderID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	return Capacity(inventories.Inventories, usages.Usages), nil
+}
@@ -0,0 +1,40 @@
+/*
+Package traits lists, checks, creates and deletes the traits known to the
+OpenStack Placement service. Standard traits are always present; custom
+traits must be prefixed with "CUSTOM_".
+
+Example to List Custom Traits
+
+	listOpts := traits.ListOpts{
+		Name: "startswith:CUSTOM_",
+	}
+
+	allPages, err := traits.List(placementClient, listOpts).AllPages()
+	if err != nil {
+		panic(err)
+	}
+
+	allTraits, err := traits.ExtractTraits(allPages)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, trait := range allTraits {
+		fmt.Println(trait)
+	}
+
+Example to Create a Custom Trait
+
+	err := traits.Create(placementClient, "CUSTOM_HW_FPGA_CLASS1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+
+Example to Delete a Custom Trait
+
+	err := traits.Delete(placementClient, "CUSTOM_HW_FPGA_CLASS1").ExtractErr()
+	if err != nil {
+		panic(err)
+	}
+*/
+package traits
@@ -0,0 +1,70 @@
+package traits
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// ListOptsBuilder allows extensions to add additional parameters to the
+// List request.
+type ListOptsBuilder interface {
+	ToTraitListQuery() (string, error)
+}
+
+// ListOpts allows the filtering of traits.
+type ListOpts struct {
+	// Name filters the traits by name, either with "startswith:<prefix>" or
+	// with "in:<name1>,<name2>".
+	Name string `q:"name"`
+
+	// Associated, when set, returns only the traits that are (true) or are
+	// not (false) associated with at least one resource provider.
+	Associated *bool `q:"associated"`
+}
+
+// ToTraitListQuery formats a ListOpts into a query string.
+func (opts ListOpts) ToTraitListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// List makes a request against the API to list traits.
+func List(client *gophercloud.ServiceClient, opts ListOptsBuilder) pagination.Pager {
+	url := listURL(client)
+
+	if opts != nil {
+		query, err := opts.ToTraitListQuery()
+		if err != nil {
+			return pagination.Pager{Err: err}
+		}
+		url += query
+	}
+
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return TraitPage{pagination.SinglePageBase(r)}
+	})
+}
+
+// Get checks whether a trait exists. ExtractErr returns a
+// gophercloud.ErrDefault404 if it does not.
+func Get(client *gophercloud.ServiceClient, name string) (r GetResult) {
+	_, r.Err = client.Get(traitURL(client, name), nil, &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// Create creates a custom trait. It succeeds if the trait already exists.
+func Create(client *gophercloud.ServiceClient, name string) (r CreateResult) {
+	_, r.Err = client.Put(traitURL(client, name), nil, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201, 204},
+	})
+	return
+}
+
+// Delete deletes a custom trait. Traits still associated with a resource
+// provider cannot be deleted.
+func Delete(client *gophercloud.ServiceClient, name string) (r DeleteResult) {
+	_, r.Err = client.Delete(traitURL(client, name), nil)
+	return
+}
@@ -0,0 +1,45 @@
+package traits
+
+import (
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/pagination"
+)
+
+// GetResult is the response from a Get operation. Call its ExtractErr method
+// to determine if the trait exists.
+type GetResult struct {
+	gophercloud.ErrResult
+}
+
+// CreateResult is the response from a Create operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type CreateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult is the response from a Delete operation. Call its ExtractErr
+// method to determine if the call succeeded or failed.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// TraitPage contains a single page of all traits from a List call.
+type TraitPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty determines if a TraitPage contains any results.
+func (page TraitPage) IsEmpty() (bool, error) {
+	traits, err := ExtractTraits(page)
+	return len(traits) == 0, err
+}
+
+// ExtractTraits returns a slice of trait names contained in a single page
+// of results.
+func ExtractTraits(r pagination.Page) ([]string, error) {
+	var s struct {
+		Traits []string `json:"traits"`
+	}
+	err := (r.(TraitPage)).ExtractInto(&s)
+	return s.Traits, err
+}
@@ -0,0 +1,2 @@
+// placement traits
+package testing
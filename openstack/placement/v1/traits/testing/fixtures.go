@@ -0,0 +1,77 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+const TraitName = "CUSTOM_HW_FPGA_CLASS1"
+
+const ListBody = `
+{
+  "traits": [
+    "CUSTOM_HW_FPGA_CLASS1",
+    "CUSTOM_HW_FPGA_CLASS2"
+  ]
+}
+`
+
+var ExpectedTraits = []string{
+	"CUSTOM_HW_FPGA_CLASS1",
+	"CUSTOM_HW_FPGA_CLASS2",
+}
+
+func HandleListSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/traits", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+		th.TestFormValues(t, r, map[string]string{
+			"name":       "startswith:CUSTOM_",
+			"associated": "true",
+		})
+
+		w.Header().Add("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ListBody)
+	})
+}
+
+func HandleGetSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/traits/"+TraitName, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func HandleGetNotFound(t *testing.T) {
+	th.Mux.HandleFunc("/traits/CUSTOM_MISSING", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNotFound)
+	})
+}
+
+func HandleCreateSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/traits/"+TraitName, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+func HandleDeleteSuccessfully(t *testing.T) {
+	th.Mux.HandleFunc("/traits/"+TraitName, func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "DELETE")
+		th.TestHeader(t, r, "X-Auth-Token", client.TokenID)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,66 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud"
+	"github.com/chjlangzi/gophercloud/openstack/placement/v1/traits"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+	"github.com/chjlangzi/gophercloud/testhelper/client"
+)
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListSuccessfully(t)
+
+	associated := true
+	listOpts := traits.ListOpts{
+		Name:       "startswith:CUSTOM_",
+		Associated: &associated,
+	}
+
+	allPages, err := traits.List(client.ServiceClient(), listOpts).AllPages()
+	th.AssertNoErr(t, err)
+
+	actual, err := traits.ExtractTraits(allPages)
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, ExpectedTraits, actual)
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetSuccessfully(t)
+	HandleGetNotFound(t)
+
+	err := traits.Get(client.ServiceClient(), TraitName).ExtractErr()
+	th.AssertNoErr(t, err)
+
+	err = traits.Get(client.ServiceClient(), "CUSTOM_MISSING").ExtractErr()
+	if _, ok := err.(gophercloud.ErrDefault404); !ok {
+		t.Fatalf("Expected ErrDefault404, got %v", err)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateSuccessfully(t)
+
+	err := traits.Create(client.ServiceClient(), TraitName).ExtractErr()
+	th.AssertNoErr(t, err)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDeleteSuccessfully(t)
+
+	err := traits.Delete(client.ServiceClient(), TraitName).ExtractErr()
+	th.AssertNoErr(t, err)
+}
@@ -0,0 +1,11 @@
+package traits
+
+import "github.com/chjlangzi/gophercloud"
+
+func listURL(client *gophercloud.ServiceClient) string {
+	return client.ServiceURL("traits")
+}
+
+func traitURL(client *gophercloud.ServiceClient, name string) string {
+	return client.ServiceURL("traits", name)
+}
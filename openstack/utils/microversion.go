@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseMicroversion splits a microversion such as "2.48" into its major and
+// minor components.
+func ParseMicroversion(microversion string) (major, minor int, err error) {
+	parts := strings.Split(microversion, ".")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("Invalid microversion format: %q", microversion)
+	}
+
+	major, err = strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid microversion format: %q", microversion)
+	}
+
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid microversion format: %q", microversion)
+	}
+
+	return major, minor, nil
+}
+
+// MicroversionAtLeast reports whether the microversion in use by a client is
+// equal to or newer than the required one. The special value "latest" is
+// always considered new enough, while an empty or malformed microversion
+// never is.
+func MicroversionAtLeast(current, required string) bool {
+	if current == "latest" {
+		return true
+	}
+
+	currentMajor, currentMinor, err := ParseMicroversion(current)
+	if err != nil {
+		return false
+	}
+
+	requiredMajor, requiredMinor, err := ParseMicroversion(required)
+	if err != nil {
+		return false
+	}
+
+	if currentMajor != requiredMajor {
+		return currentMajor > requiredMajor
+	}
+	return currentMinor >= requiredMinor
+}
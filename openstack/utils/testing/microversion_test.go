@@ -0,0 +1,35 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/chjlangzi/gophercloud/openstack/utils"
+	th "github.com/chjlangzi/gophercloud/testhelper"
+)
+
+func TestParseMicroversion(t *testing.T) {
+	major, minor, err := utils.ParseMicroversion("2.48")
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, 2, major)
+	th.AssertEquals(t, 48, minor)
+
+	_, _, err = utils.ParseMicroversion("2")
+	if err == nil {
+		t.Fatal("Expected an error for a microversion without a minor part")
+	}
+
+	_, _, err = utils.ParseMicroversion("latest")
+	if err == nil {
+		t.Fatal("Expected an error for a non-numeric microversion")
+	}
+}
+
+func TestMicroversionAtLeast(t *testing.T) {
+	th.AssertEquals(t, true, utils.MicroversionAtLeast("2.48", "2.48"))
+	th.AssertEquals(t, true, utils.MicroversionAtLeast("2.60", "2.48"))
+	th.AssertEquals(t, true, utils.MicroversionAtLeast("3.0", "2.48"))
+	th.AssertEquals(t, true, utils.MicroversionAtLeast("latest", "2.48"))
+	th.AssertEquals(t, false, utils.MicroversionAtLeast("2.9", "2.48"))
+	th.AssertEquals(t, false, utils.MicroversionAtLeast("1.99", "2.48"))
+	th.AssertEquals(t, false, utils.MicroversionAtLeast("", "2.48"))
+}